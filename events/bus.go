@@ -0,0 +1,186 @@
+package events
+
+import (
+	"sync"
+	"time"
+)
+
+// Kind identifies the type of payload carried on the Bus
+type Kind int
+
+const (
+	PlayerJoinedKind Kind = iota
+	PlayerDiedKind
+	NPCKilledKind
+	ItemCraftedKind
+	FloraHarvestedKind
+	ChatKind
+	SeasonChangedKind
+	ModeratedKind
+	PlayerRespawnedKind
+)
+
+var kindNames = []string{"player_joined", "player_died", "npc_killed", "item_crafted", "flora_harvested", "chat", "season_changed", "moderated", "player_respawned"}
+
+func (k Kind) String() string {
+	return kindNames[k]
+}
+
+// Payload is the structured data describing something that happened in the world
+type Payload interface {
+	Kind() Kind
+}
+
+type PlayerJoined struct {
+	Name string `json:"name"`
+}
+
+type PlayerDied struct {
+	Name  string `json:"name"`
+	Cause string `json:"cause"`
+	Days  int    `json:"days"` // days survived
+}
+
+type PlayerRespawned struct {
+	Name string `json:"name"`
+}
+
+type NPCKilled struct {
+	NPC    string `json:"npc"`
+	Weapon string `json:"weapon"` // item id
+}
+
+type ItemCrafted struct {
+	Item string `json:"item"` // item id
+}
+
+// FloraHarvested is published every time harvesting yields something, and when the plant is used up
+type FloraHarvested struct {
+	Flora  string         `json:"flora"`  // flora id
+	Yield  map[string]int `json:"yield"`  // item id => quantity
+	Felled bool           `json:"felled"` // the plant is gone
+}
+
+type ChatMessage struct {
+	Name    string `json:"name"`
+	Channel string `json:"channel"`      // say, shout, global or whisper
+	To      string `json:"to,omitempty"` // name of the recipient of a whisper
+	Text    string `json:"text"`
+	Emote   bool   `json:"emote,omitempty"`
+}
+
+type SeasonChanged struct {
+	Season string `json:"season"`
+	Year   int    `json:"year"`
+}
+
+// Moderated is published when a moderator acts on a player, or clears the chat
+type Moderated struct {
+	Moderator string     `json:"moderator,omitempty"` // name of the player who moderated
+	Action    string     `json:"action"`              // silence, kick, ban or clear
+	Target    string     `json:"target,omitempty"`    // name of the player acted on
+	Until     *time.Time `json:"until,omitempty"`     // when a silence or ban ends
+}
+
+func (PlayerJoined) Kind() Kind    { return PlayerJoinedKind }
+func (PlayerDied) Kind() Kind      { return PlayerDiedKind }
+func (NPCKilled) Kind() Kind       { return NPCKilledKind }
+func (ItemCrafted) Kind() Kind     { return ItemCraftedKind }
+func (FloraHarvested) Kind() Kind  { return FloraHarvestedKind }
+func (ChatMessage) Kind() Kind     { return ChatKind }
+func (SeasonChanged) Kind() Kind   { return SeasonChangedKind }
+func (Moderated) Kind() Kind       { return ModeratedKind }
+func (PlayerRespawned) Kind() Kind { return PlayerRespawnedKind }
+
+// Envelope is a Payload along with when, where and by whom it happened
+type Envelope struct {
+	Time    time.Time
+	Actor   string // id of the player who caused it, if any
+	X, Y    int
+	Payload Payload
+}
+
+// Kind is the kind of the payload
+func (e Envelope) Kind() Kind {
+	return e.Payload.Kind()
+}
+
+// Handler receives envelopes from the Bus. handlers are called synchronously by the publisher, which
+// may be holding the world lock, so they must return quickly and must not call back into the world.
+type Handler func(Envelope)
+
+// BusFilter decides whether a subscriber wants an envelope
+type BusFilter func(Envelope) bool
+
+// OfKind matches envelopes with any of the given kinds
+func OfKind(kinds ...Kind) BusFilter {
+	return func(e Envelope) bool {
+		for _, k := range kinds {
+			if e.Kind() == k {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// ByActor matches envelopes caused by the given player
+func ByActor(playerID string) BusFilter {
+	return func(e Envelope) bool {
+		return e.Actor == playerID
+	}
+}
+
+type busSubscriber struct {
+	handler Handler
+	filters []BusFilter
+}
+
+func (s busSubscriber) wants(e Envelope) bool {
+	for _, f := range s.filters {
+		if !f(e) {
+			return false
+		}
+	}
+	return true
+}
+
+// Bus carries structured world events to any number of subscribers, eg the feed, logging and metrics
+type Bus struct {
+	sync.RWMutex
+	subscribers map[int]busSubscriber
+	next        int
+}
+
+func NewBus() *Bus {
+	return &Bus{subscribers: make(map[int]busSubscriber)}
+}
+
+// Subscribe registers h to receive every envelope that matches all of the filters. call the returned
+// func to unsubscribe.
+func (b *Bus) Subscribe(h Handler, filters ...BusFilter) func() {
+	b.Lock()
+	defer b.Unlock()
+	id := b.next
+	b.next++
+	b.subscribers[id] = busSubscriber{handler: h, filters: filters}
+	return func() {
+		b.Lock()
+		defer b.Unlock()
+		delete(b.subscribers, id)
+	}
+}
+
+// Publish stamps the envelope with the current time if it has none, and delivers it to subscribers
+func (b *Bus) Publish(e Envelope) {
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	b.RLock()
+	defer b.RUnlock()
+	for _, s := range b.subscribers {
+		if s.wants(e) {
+			s.handler(e)
+		}
+	}
+}
@@ -1,7 +1,13 @@
 package events
 
 import (
+	"fmt"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/dustmason/nicefort/i18n"
+	"hash/fnv"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 )
 import "container/list"
@@ -15,41 +21,248 @@ const (
 	Info
 )
 
+// Topic is what an event is about, independent of its Class. Topics are used to filter the message log.
+type Topic int
+
+const (
+	System Topic = iota
+	Combat
+	Harvesting
+	Crafting
+	Chat
+)
+
+var Topics = []Topic{Combat, Harvesting, Crafting, Chat, System}
+
+func (t Topic) String() string {
+	switch t {
+	case Combat:
+		return "combat"
+	case Harvesting:
+		return "harvesting"
+	case Crafting:
+		return "crafting"
+	case Chat:
+		return "chat"
+	default:
+		return "system"
+	}
+}
+
+var classStyles = map[Class]lipgloss.Style{
+	Warning: lipgloss.NewStyle().Foreground(lipgloss.Color("#FDC300")),
+	Success: lipgloss.NewStyle().Foreground(lipgloss.Color("#7BC96F")),
+	Danger:  lipgloss.NewStyle().Foreground(lipgloss.Color("#FF5F5F")).Bold(true),
+	Info:    lipgloss.NewStyle().Foreground(lipgloss.Color("#C1C6B2")),
+}
+
+var timestampStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#666666"))
+var countStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#888888"))
+
+// subjectColors is the palette chat subjects are assigned from. a given subject always
+// hashes to the same color so each player is recognizable in the feed.
+var subjectColors = []string{
+	"#FF7CCB",
+	"#7CC4FF",
+	"#FDFF8C",
+	"#9BFF7C",
+	"#C77CFF",
+	"#FFB07C",
+	"#7CFFE4",
+	"#FF7C7C",
+}
+
+func subjectStyle(subject string) lipgloss.Style {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(subject))
+	c := subjectColors[h.Sum32()%uint32(len(subjectColors))]
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(c)).Bold(true)
+}
+
+// TimestampMode is a player's preference for how event timestamps are shown
+type TimestampMode int
+
+const (
+	NoTimestamps TimestampMode = iota
+	RelativeTimestamps
+	GameTimestamps
+)
+
+// Next cycles through the available modes
+func (m TimestampMode) Next() TimestampMode {
+	return (m + 1) % 3
+}
+
+// TimeFormatter renders the timestamp of an event. A nil TimeFormatter means no timestamps are shown.
+type TimeFormatter func(time.Time) string
+
+// RelativeTime renders t as a short duration relative to now, eg "12s", "3m", "1h"
+func RelativeTime(t time.Time) string {
+	d := time.Since(t)
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	default:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	}
+}
+
 type Event struct {
 	kind    Class
-	text    string
-	subject string // optional element
+	topic   Topic
+	msg     i18n.Message
+	subject string       // optional element
+	channel i18n.Message // optional chat channel, shown before the subject, eg "[say ↗ 12]"
+	emote   bool         // rendered as an action by the subject, eg "* jordan waves"
 	when    time.Time
+	count   int // how many identical events were coalesced into this one
+}
+
+func (e Event) render(b *strings.Builder, tf TimeFormatter, l i18n.Locale) {
+	if tf != nil {
+		b.WriteString(timestampStyle.Render(tf(e.when)) + " ")
+	}
+	if ch := e.channel.In(l); ch != "" {
+		b.WriteString(countStyle.Render("["+ch+"]") + " ")
+	}
+	switch {
+	case e.emote:
+		b.WriteString(classStyles[e.kind].Render("* ") + subjectStyle(e.subject).Render(e.subject) + " ")
+	case e.subject != "":
+		b.WriteString(subjectStyle(e.subject).Render(e.subject) + ": ")
+	}
+	b.WriteString(classStyles[e.kind].Render(e.msg.In(l)))
+	if e.count > 1 {
+		b.WriteString(countStyle.Render(fmt.Sprintf(" (x%d)", e.count)))
+	}
 }
 
-func (e Event) render(b *strings.Builder) {
-	// todo styling based on kind
-	if e.subject != "" {
+// Text renders the event as plain text, without styles or timestamps, eg for screen readers
+func (e Event) Text(l i18n.Locale) string {
+	var b strings.Builder
+	if ch := e.channel.In(l); ch != "" {
+		b.WriteString("[" + ch + "] ")
+	}
+	switch {
+	case e.emote:
+		b.WriteString("* " + e.subject + " ")
+	case e.subject != "":
 		b.WriteString(e.subject + ": ")
 	}
-	b.WriteString(e.text)
+	b.WriteString(e.msg.In(l))
+	if e.count > 1 {
+		b.WriteString(fmt.Sprintf(" (x%d)", e.count))
+	}
+	return b.String()
+}
+
+// Subject is who the event is from, or "" if it isn't from anyone in particular
+func (e Event) Subject() string {
+	return e.subject
+}
+
+func (e Event) same(o Event) bool {
+	return e.kind == o.kind && e.topic == o.topic && e.msg.String() == o.msg.String() && e.subject == o.subject && e.channel.String() == o.channel.String() && e.emote == o.emote
+}
+
+// Filter selects events for the message log. A nil Topics map matches every topic.
+type Filter struct {
+	Topics map[Topic]bool
+	Query  string
+	Locale i18n.Locale // Query is matched against the text as shown in this locale
+}
+
+func (f Filter) Match(e Event) bool {
+	if f.Topics != nil && !f.Topics[e.topic] {
+		return false
+	}
+	if f.Query == "" {
+		return true
+	}
+	q := strings.ToLower(f.Query)
+	return strings.Contains(strings.ToLower(e.msg.In(f.Locale)), q) || strings.Contains(strings.ToLower(e.subject), q)
+}
+
+// Merge combines several lists of events into one, ordered oldest first
+func Merge(lists ...[]Event) []Event {
+	out := make([]Event, 0)
+	for _, l := range lists {
+		out = append(out, l...)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].when.Before(out[j].when)
+	})
+	return out
+}
+
+// Render renders a slice of events, one per line
+func Render(evs []Event, tf TimeFormatter, l i18n.Locale) string {
+	var b strings.Builder
+	for _, e := range evs {
+		e.render(&b, tf, l)
+		b.WriteString("\n")
+	}
+	return b.String()
 }
 
 type EventList struct {
+	sync.Mutex
 	len int
 	list.List
-	// todo add option to render timestamps?
+	timeFormatter TimeFormatter
+	locale        i18n.Locale
 }
 
 func NewEventList(len int) *EventList {
-	return &EventList{len: len}
+	return &EventList{len: len, locale: i18n.English}
+}
+
+// SetTimeFormatter controls how (and whether) timestamps are rendered. Pass nil to hide them.
+func (el *EventList) SetTimeFormatter(tf TimeFormatter) {
+	el.Lock()
+	defer el.Unlock()
+	el.timeFormatter = tf
+}
+
+// SetLocale controls the language the list is rendered in
+func (el *EventList) SetLocale(l i18n.Locale) {
+	el.Lock()
+	defer el.Unlock()
+	el.locale = l
+}
+
+func (el *EventList) Add(kind Class, topic Topic, msg i18n.Message) {
+	el.AddWithSubject(kind, topic, msg, "")
+}
+
+func (el *EventList) AddWithSubject(kind Class, topic Topic, msg i18n.Message, subject string) {
+	el.add(Event{kind: kind, topic: topic, msg: msg, subject: subject})
 }
 
-func (el *EventList) Add(kind Class, text string) {
-	el.AddWithSubject(kind, text, "")
+// AddChat adds a chat message from subject on the given channel
+func (el *EventList) AddChat(kind Class, channel, msg i18n.Message, subject string) {
+	el.add(Event{kind: kind, topic: Chat, channel: channel, msg: msg, subject: subject})
 }
 
-func (el *EventList) AddWithSubject(kind Class, text, subject string) {
-	e := Event{
-		kind:    kind,
-		text:    text,
-		subject: subject,
-		when:    time.Now(),
+// AddEmote adds a chat action performed by subject, eg "/me waves"
+func (el *EventList) AddEmote(kind Class, channel, msg i18n.Message, subject string) {
+	el.add(Event{kind: kind, topic: Chat, channel: channel, msg: msg, subject: subject, emote: true})
+}
+
+func (el *EventList) add(e Event) {
+	el.Lock()
+	defer el.Unlock()
+	e.when = time.Now()
+	e.count = 1
+	// coalesce repeats of the most recent event into a single line with a count
+	if front := el.Front(); front != nil {
+		if prev := front.Value.(Event); prev.same(e) {
+			e.count = prev.count + 1
+			front.Value = e
+			return
+		}
 	}
 	el.PushFront(e)
 	for el.Len() > el.len {
@@ -58,28 +271,65 @@ func (el *EventList) AddWithSubject(kind Class, text, subject string) {
 	}
 }
 
+// RemoveTopic drops every event about topic from the list
+func (el *EventList) RemoveTopic(topic Topic) {
+	el.Lock()
+	defer el.Unlock()
+	for n := el.Front(); n != nil; {
+		next := n.Next()
+		if n.Value.(Event).topic == topic {
+			el.Remove(n)
+		}
+		n = next
+	}
+}
+
 func (el *EventList) Render() string {
-	var b strings.Builder
+	return el.RenderWith(el.TimeFormatter(), el.Locale())
+}
+
+// RenderWith renders the list using tf for timestamps and l for text instead of the list's own settings
+func (el *EventList) RenderWith(tf TimeFormatter, l i18n.Locale) string {
+	return Render(el.Events(), tf, l)
+}
+
+// RenderLast renders only the n most recent events
+func (el *EventList) RenderLast(n int) string {
+	return Render(el.Last(n), el.TimeFormatter(), el.Locale())
+}
+
+// Last returns the n most recent events, oldest first
+func (el *EventList) Last(n int) []Event {
+	evs := el.Events()
+	if len(evs) > n {
+		evs = evs[len(evs)-n:]
+	}
+	return evs
+}
+
+// Events returns a copy of the list, oldest first
+func (el *EventList) Events() []Event {
+	el.Lock()
+	defer el.Unlock()
+	out := make([]Event, 0, el.Len())
 	n := el.Back()
 	for n != nil {
-		// out = append(out, n.Value.(Event))
-		n.Value.(Event).render(&b)
-		b.WriteString("\n")
+		out = append(out, n.Value.(Event))
 		n = n.Prev()
 	}
-	// for _, e := range el.Events() {
-	// 	e.render(&b)
-	// 	b.WriteString("\n")
-	// }
-	return b.String()
+	return out
 }
 
-// func (el *EventList) Events() []Event {
-// 	out := make([]Event, 0)
-// 	n := el.Back()
-// 	for n != nil {
-// 		out = append(out, n.Value.(Event))
-// 		n = n.Prev()
-// 	}
-// 	return out
-// }
+// TimeFormatter returns the list's current timestamp setting
+func (el *EventList) TimeFormatter() TimeFormatter {
+	el.Lock()
+	defer el.Unlock()
+	return el.timeFormatter
+}
+
+// Locale returns the list's current language setting
+func (el *EventList) Locale() i18n.Locale {
+	el.Lock()
+	defer el.Unlock()
+	return el.locale
+}
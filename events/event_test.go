@@ -0,0 +1,83 @@
+package events
+
+import (
+	"github.com/dustmason/nicefort/i18n"
+	"testing"
+)
+
+func TestEventListCoalesces(t *testing.T) {
+	el := NewEventList(10)
+	el.Add(Info, System, i18n.M("It starts to rain"))
+	el.Add(Info, System, i18n.M("It starts to rain"))
+	el.Add(Info, System, i18n.M("It starts to rain"))
+	el.AddWithSubject(Info, System, i18n.M("It starts to rain"), "pat") // different subject
+	el.AddChat(Info, i18n.M("say"), i18n.M("hi"), "pat")
+	el.AddChat(Info, i18n.M("shout"), i18n.M("hi"), "pat") // different channel
+	el.AddEmote(Info, i18n.M("say"), i18n.M("hi"), "pat")  // an emote, not a message
+	el.Add(Info, System, i18n.M("It starts to rain"))      // not a repeat of the most recent event
+	el.Add(Warning, System, i18n.M("It starts to rain"))   // different class
+	el.Add(Warning, Chat, i18n.M("It starts to rain"))     // different topic
+	tests := []struct {
+		text  string
+		count int
+	}{
+		{"It starts to rain (x3)", 3},
+		{"pat: It starts to rain", 1},
+		{"[say] pat: hi", 1},
+		{"[shout] pat: hi", 1},
+		{"[say] * pat hi", 1},
+		{"It starts to rain", 1},
+		{"It starts to rain", 1},
+		{"It starts to rain", 1},
+	}
+	evs := el.Events()
+	if len(evs) != len(tests) {
+		t.Fatalf("list has %d events, want %d", len(evs), len(tests))
+	}
+	for i, tt := range tests {
+		if got := evs[i].Text(i18n.English); got != tt.text || evs[i].count != tt.count {
+			t.Errorf("event %d = %q (x%d), want %q (x%d)", i, got, evs[i].count, tt.text, tt.count)
+		}
+	}
+}
+
+func TestEventListLength(t *testing.T) {
+	el := NewEventList(2)
+	el.Add(Info, System, i18n.M("one"))
+	el.Add(Info, System, i18n.M("two"))
+	el.Add(Info, System, i18n.M("two"))
+	el.Add(Info, System, i18n.M("three"))
+	evs := el.Events()
+	if len(evs) != 2 || evs[0].Text(i18n.English) != "two (x2)" || evs[1].Text(i18n.English) != "three" {
+		t.Errorf("list is %v, want the two most recent events", evs)
+	}
+}
+
+func TestFilterMatch(t *testing.T) {
+	rain := Event{topic: System, msg: i18n.M("It starts to rain")}
+	berries := Event{topic: Harvesting, msg: i18n.M("You ate a handful of delicious cloudberries")}
+	chat := Event{topic: Chat, msg: i18n.M("hello"), subject: "Pat"}
+	tests := []struct {
+		name   string
+		filter Filter
+		e      Event
+		want   bool
+	}{
+		{"empty filter", Filter{}, rain, true},
+		{"topic on", Filter{Topics: map[Topic]bool{System: true}}, rain, true},
+		{"topic off", Filter{Topics: map[Topic]bool{System: false, Chat: true}}, rain, false},
+		{"no topics", Filter{Topics: map[Topic]bool{}}, rain, false},
+		{"query in text", Filter{Query: "RAIN"}, rain, true},
+		{"query not in text", Filter{Query: "snow"}, rain, false},
+		{"query in subject", Filter{Query: "pat"}, chat, true},
+		{"topic and query", Filter{Topics: map[Topic]bool{Chat: true}, Query: "pat"}, chat, true},
+		{"topic but not query", Filter{Topics: map[Topic]bool{Chat: true}, Query: "bye"}, chat, false},
+		{"query in locale", Filter{Query: "lakkoja", Locale: i18n.Finnish}, berries, true},
+		{"query in english only", Filter{Query: "cloudberries", Locale: i18n.Finnish}, berries, false},
+	}
+	for _, tt := range tests {
+		if got := tt.filter.Match(tt.e); got != tt.want {
+			t.Errorf("%s: Match() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package events
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const logBuffer = 4096 // envelopes queued for writing. past that they're dropped rather than holding up publishers
+
+// logRecord is one line of the JSON log
+type logRecord struct {
+	Time    time.Time `json:"time"`
+	Kind    string    `json:"kind"`
+	Actor   string    `json:"actor,omitempty"` // SHA256 fingerprint of the player's public key
+	X       int       `json:"x"`
+	Y       int       `json:"y"`
+	Payload Payload   `json:"payload"`
+}
+
+// JSONLog writes every envelope it receives to a file, one JSON object per line. when the file grows
+// past maxSize it is rotated: path becomes path.1, path.1 becomes path.2 and so on, keeping at most
+// keep old files.
+type JSONLog struct {
+	sync.Mutex // guards closed, so nothing is queued after Close
+	path       string
+	maxSize    int64
+	keep       int
+	file       *os.File
+	size       int64
+	queue      chan Envelope
+	done       chan struct{}
+	closed     bool
+	dropped    atomic.Int64 // envelopes that didn't fit in the queue, reported with the next write
+}
+
+func NewJSONLog(path string, maxSize int64, keep int) (*JSONLog, error) {
+	l := &JSONLog{
+		path:    path,
+		maxSize: maxSize,
+		keep:    keep,
+		queue:   make(chan Envelope, logBuffer),
+		done:    make(chan struct{}),
+	}
+	if err := l.open(); err != nil {
+		return nil, err
+	}
+	go l.run()
+	return l, nil
+}
+
+// Handle queues an envelope to be written. it's meant to be subscribed to a Bus, and publishers often
+// hold the world lock, so it never waits: when the queue is full, or the log is closed, the envelope
+// is dropped.
+func (l *JSONLog) Handle(e Envelope) {
+	l.Lock()
+	defer l.Unlock()
+	if l.closed {
+		return
+	}
+	select {
+	case l.queue <- e:
+	default:
+		l.dropped.Add(1)
+	}
+}
+
+// Close writes out anything still queued and closes the file. anything handled afterwards is dropped.
+func (l *JSONLog) Close() error {
+	l.Lock()
+	if l.closed {
+		l.Unlock()
+		return nil
+	}
+	l.closed = true
+	close(l.queue)
+	l.Unlock()
+	<-l.done
+	return l.file.Close()
+}
+
+func (l *JSONLog) run() {
+	defer close(l.done)
+	for e := range l.queue {
+		if err := l.write(e); err != nil {
+			log.Println("event log:", err)
+		}
+		if n := l.dropped.Swap(0); n > 0 {
+			log.Printf("event log: dropped %d events, the queue was full", n)
+		}
+	}
+}
+
+func (l *JSONLog) write(e Envelope) error {
+	line, err := json.Marshal(logRecord{
+		Time:    e.Time,
+		Kind:    e.Kind().String(),
+		Actor:   Fingerprint(e.Actor),
+		X:       e.X,
+		Y:       e.Y,
+		Payload: e.Payload,
+	})
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+	if l.size > 0 && l.size+int64(len(line)) > l.maxSize {
+		if err := l.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := l.file.Write(line)
+	l.size += int64(n)
+	return err
+}
+
+func (l *JSONLog) open() error {
+	f, err := os.OpenFile(l.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	l.file = f
+	l.size = info.Size()
+	return nil
+}
+
+func (l *JSONLog) rotate() error {
+	if err := l.file.Close(); err != nil {
+		return err
+	}
+	for i := l.keep - 1; i > 0; i-- {
+		_ = os.Rename(fmt.Sprintf("%s.%d", l.path, i), fmt.Sprintf("%s.%d", l.path, i+1))
+	}
+	if l.keep > 0 {
+		if err := os.Rename(l.path, l.path+".1"); err != nil {
+			return err
+		}
+	} else if err := os.Remove(l.path); err != nil {
+		return err
+	}
+	return l.open()
+}
+
+// Fingerprint returns the SHA256 fingerprint of a public key in authorized_keys format, the same as
+// `ssh-keygen -l` shows, or "" if it isn't a key
+func Fingerprint(authorizedKey string) string {
+	f := strings.Fields(authorizedKey)
+	if len(f) < 2 {
+		return ""
+	}
+	blob, err := base64.StdEncoding.DecodeString(f[1])
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(blob)
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,101 @@
+package events
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func countLines(t *testing.T, path string) int {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	n := 0
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		var r map[string]interface{}
+		if err := json.Unmarshal(s.Bytes(), &r); err != nil {
+			t.Fatalf("%s line %d: %v", path, n+1, err)
+		}
+		n++
+	}
+	return n
+}
+
+func envelope() Envelope {
+	return Envelope{Time: time.Unix(0, 0), X: 1, Y: 2, Payload: PlayerJoined{Name: "pat"}}
+}
+
+func TestJSONLogRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	line, _ := json.Marshal(logRecord{Time: time.Unix(0, 0), Kind: PlayerJoinedKind.String(), X: 1, Y: 2, Payload: PlayerJoined{Name: "pat"}})
+	size := int64(len(line) + 1)
+	l, err := NewJSONLog(path, size*3, 2) // three lines to a file
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 11; i++ {
+		l.Handle(envelope())
+	}
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		path string
+		want int
+	}{
+		{path, 2},
+		{path + ".1", 3},
+		{path + ".2", 3},
+	}
+	for _, tt := range tests {
+		if got := countLines(t, tt.path); got != tt.want {
+			t.Errorf("%s has %d lines, want %d", filepath.Base(tt.path), got, tt.want)
+		}
+	}
+	if _, err := os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Errorf("kept more than 2 rotated files")
+	}
+}
+
+func TestJSONLogHandleAfterClose(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	l, err := NewJSONLog(path, 1<<20, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.Handle(envelope())
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+	l.Handle(envelope()) // must not panic
+	if err := l.Close(); err != nil {
+		t.Errorf("second Close() = %v", err)
+	}
+	if got := countLines(t, path); got != 1 {
+		t.Errorf("log has %d lines, want 1", got)
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{"", ""},
+		{"ssh-ed25519", ""},
+		{"ssh-ed25519 !!!", ""},
+		{"ssh-ed25519 AAAA", "SHA256:cJ6AyISHokEeHuTfufIqhhSS0gxHZRUMDHlKvXD4FHw"}, // sha256 of three zero bytes
+	}
+	for _, tt := range tests {
+		if got := Fingerprint(tt.key); got != tt.want {
+			t.Errorf("Fingerprint(%q) = %q, want %q", tt.key, got, tt.want)
+		}
+	}
+}
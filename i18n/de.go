@@ -0,0 +1,442 @@
+package i18n
+
+var german = map[string]string{
+	// events
+	"%s joined.":                                  "%s ist beigetreten.",
+	"%s left.":                                    "%s ist gegangen.",
+	"RIP %s":                                      "Ruhe in Frieden, %s",
+	"%s washed ashore.":                           "%s wurde angespült.",
+	"You killed the %s":                           "Du hast %s erlegt",
+	"It dropped %d x %s":                          "Es hinterließ %d x %s",
+	"Your %s doesn't do anything to the %s":       "%s richtet nichts gegen %s aus",
+	"You hit the %s for %d":                       "Du triffst %s mit %d Schaden",
+	"It yielded %d x %s":                          "Ertrag: %d x %s",
+	"You harvested the %s":                        "Du hast %s abgeerntet",
+	"Your %s does not work here":                  "%s hilft hier nicht",
+	"There's no room to drop that here":           "Hier ist kein Platz, um das abzulegen",
+	"You dropped %d x %s":                         "Du hast %d x %s abgelegt",
+	"You crafted a %s":                            "Hergestellt: %s",
+	"You can't craft a %s yet":                    "%s kannst du noch nicht herstellen",
+	"You picked up %d x %s":                       "Aufgehoben: %d x %s",
+	"You put away the %s":                         "Du steckst %s weg",
+	"Now wielding: %s":                            "In der Hand: %s",
+	"The %s attacked you! You lost %d health":     "%s greift dich an! Du verlierst %d Gesundheit",
+	"The %s missed!":                              "%s verfehlt dich!",
+	"You ate a handful of delicious cloudberries": "Du isst eine Handvoll köstlicher Moltebeeren",
+
+	// status and sidebar
+	"%s : Year %d, Day %d":   "%s : Jahr %d, Tag %d",
+	"Location : %d, %d":      "Position : %d, %d",
+	"Pack: %.1f / %d":        "Gepäck: %.1f / %d",
+	"Health: %d / %d":        "Gesundheit: %d / %d",
+	"Hunger: %s":             "Hunger: %s",
+	"Fed":                    "Satt",
+	"Peckish":                "Etwas hungrig",
+	"Hungry":                 "Hungrig",
+	"Starving":               "Am Verhungern",
+	"You're getting peckish": "Du bekommst etwas Hunger",
+	"You're hungry. You move and work more slowly":       "Du bist hungrig. Du bewegst dich und arbeitest langsamer",
+	"You're starving! Eat something before it kills you": "Du verhungerst! Iss etwas, bevor es dich umbringt",
+	"You are starving. You lost %d health":               "Du verhungerst. Du verlierst %d Gesundheit",
+	"Thirst: %s":                                         "Durst: %s",
+	"Quenched":                                           "Gelöscht",
+	"Thirsty":                                            "Durstig",
+	"Parched":                                            "Ausgedörrt",
+	"Dehydrated":                                         "Am Verdursten",
+	"You're thirsty":                                     "Du hast Durst",
+	"You're parched. You move and work more slowly":     "Du bist ausgedörrt. Du bewegst dich und arbeitest langsamer",
+	"You're dehydrated! Find water before it kills you": "Du verdurstest! Finde Wasser, bevor es dich umbringt",
+	"You are dehydrated. You lost %d health":            "Du verdurstest. Du verlierst %d Gesundheit",
+	"You drink the brackish bog water":                  "Du trinkst das brackige Moorwasser",
+	"You drink your fill of cold, clear water":          "Du trinkst dich an kaltem, klarem Wasser satt",
+	"You filled a %s": "Gefüllt: %s",
+	"The bog water makes you sick. You lost %d health": "Das Moorwasser macht dich krank. Du verlierst %d Gesundheit",
+	"You drink from the %s":                            "Du trinkst aus: %s",
+	"Warmth: %s":                                       "Wärme: %s",
+	"Warm":                                             "Warm",
+	"Cold":                                             "Kalt",
+	"Hypothermic":                                      "Unterkühlt",
+	"Freezing":                                         "Am Erfrieren",
+	"You're getting cold":                              "Dir wird kalt",
+	"You're hypothermic. You move and work more slowly":  "Du bist unterkühlt. Du bewegst dich und arbeitest langsamer",
+	"You're freezing to death! Get to a fire or shelter": "Du erfrierst! Geh zu einem Feuer oder in einen Unterschlupf",
+	"You are freezing. You lost %d health":               "Du erfrierst. Du verlierst %d Gesundheit",
+	"%s, %d°C":                                           "%s, %d°C",
+	"Clear":                                              "Klar",
+	"Cloudy":                                             "Bewölkt",
+	"Rain":                                               "Regen",
+	"Storm":                                              "Sturm",
+	"Snow":                                               "Schnee",
+	"The sky clears":                                     "Der Himmel klart auf",
+	"Clouds roll in":                                     "Wolken ziehen auf",
+	"It starts to rain":                                  "Es fängt an zu regnen",
+	"A storm is coming":                                  "Ein Sturm zieht auf",
+	"It starts to snow":                                  "Es fängt an zu schneien",
+	"Fatigue: %s":                                        "Müdigkeit: %s",
+	"Rested":                                             "Ausgeruht",
+	"Tired":                                              "Müde",
+	"Weary":                                              "Erschöpft",
+	"Exhausted":                                          "Völlig erschöpft",
+	"You're getting tired":                               "Du wirst müde",
+	"You're weary. You move and work more slowly":     "Du bist erschöpft. Du bewegst dich und arbeitest langsamer",
+	"You're exhausted! Rest or sleep before you drop": "Du bist völlig erschöpft! Ruh dich aus oder schlaf, bevor du umfällst",
+	"Resting":            "Am Ausruhen",
+	"Sleeping":           "Am Schlafen",
+	"%s.":                "%s.",
+	"You wake up rested": "Du wachst ausgeruht auf",
+	"Something dangerous is close. You get up":    "Etwas Gefährliches ist in der Nähe. Du stehst auf",
+	"The %s is on you! You scramble to your feet": "%s greift dich an! Du springst auf",
+	"You get up":                             "Du stehst auf",
+	"You can't rest with danger nearby":      "Du kannst dich nicht ausruhen, wenn Gefahr droht",
+	"You sit down to rest":                   "Du setzt dich hin, um dich auszuruhen",
+	"You can't sleep with danger nearby":     "Du kannst nicht schlafen, wenn Gefahr droht",
+	"You need a bedroll or shelter to sleep": "Du brauchst eine Schlafmatte oder einen Unterschlupf zum Schlafen",
+	"You aren't tired enough to sleep":       "Du bist nicht müde genug zum Schlafen",
+	"You lie down and fall asleep":           "Du legst dich hin und schläfst ein",
+	"You put on the %s":                      "Du ziehst %s an",
+	"You take off the %s":                    "Du ziehst %s aus",
+	"Spring":                                 "Frühling",
+	"Summer":                                 "Sommer",
+	"Fall":                                   "Herbst",
+	"Winter":                                 "Winter",
+	"%s has begun":                           "%s hat begonnen",
+
+	// layout and help
+	"Map":                        "Karte",
+	"Status":                     "Status",
+	"Chat":                       "Chat",
+	"(tab to switch)":            "(Tab zum Wechseln)",
+	"terminal too small":         "Terminal zu klein",
+	"%dx%d, need at least %dx%d": "%dx%d, mindestens %dx%d nötig",
+	"chat":                       "Chat",
+	"search":                     "Suche",
+	"move up":                    "nach oben",
+	"move down":                  "nach unten",
+	"move left":                  "nach links",
+	"move right":                 "nach rechts",
+	"move northwest":             "nach Nordwesten",
+	"move northeast":             "nach Nordosten",
+	"move southwest":             "nach Südwesten",
+	"move southeast":             "nach Südosten",
+	"toggle help":                "Hilfe ein/aus",
+	"focus chat input":           "Chat schreiben",
+	"show inventory browser":     "Inventar zeigen",
+	"show message log":           "Nachrichtenprotokoll zeigen",
+	"toggle topic":               "Thema ein/aus",
+	"cycle event timestamps":     "Zeitstempel wechseln",
+	"toggle text mode":           "Textmodus ein/aus",
+	"change language":            "Sprache wechseln",
+	"rest":                       "ausruhen",
+	"sleep":                      "schlafen",
+	"respawn":                    "wiederkehren",
+	"drop one":                   "eins ablegen",
+	"drop some":                  "einige ablegen",
+	"drop all":                   "alle ablegen",
+	"change sort":                "Sortierung ändern",
+	"quit":                       "beenden",
+	"combat":                     "Kampf",
+	"harvesting":                 "Ernte",
+	"crafting":                   "Handwerk",
+	"system":                     "System",
+
+	// inventory and crafting
+	"Inventory":                           "Inventar",
+	"Item":                                "Gegenstand",
+	"Qty":                                 "Anz.",
+	"Weight":                              "Gewicht",
+	"Crafting Recipes":                    "Rezepte",
+	"recipe":                              "Rezept",
+	"recipes":                             "Rezepte",
+	"needs %s":                            "braucht %s",
+	"near a %s":                           "in der Nähe: %s",
+	"wielding a %s":                       "in der Hand: %s",
+	"by %s · x/n/X drop":                  "nach %s · x/n/X ablegen",
+	"drop how many?":                      "wie viele ablegen?",
+	"wielding":                            "in der Hand",
+	"%s, %.2f each, %.1f total":           "%s, je %.2f, insgesamt %.1f",
+	"Traits: %s":                          "Eigenschaften: %s",
+	"enter to use":                        "Enter zum Benutzen",
+	"name":                                "Name",
+	"weight":                              "Gewicht",
+	"quantity":                            "Menge",
+	"category":                            "Kategorie",
+	"Food":                                "Nahrung",
+	"Tool":                                "Werkzeug",
+	"Fuel":                                "Brennstoff",
+	"Material":                            "Material",
+	"Weapon":                              "Waffe",
+	"Digger":                              "Grabwerkzeug",
+	"Axe":                                 "Axt",
+	"Knife":                               "Messer",
+	"Kindling":                            "Zunder",
+	"Edible":                              "Essbar",
+	"Sticks":                              "Stöcke",
+	"Clothing":                            "Kleidung",
+	"wearing":                             "angezogen",
+	"Twine x 3, Sticks x 3, Kindling x 3": "Schnur x 3, Stöcke x 3, Zunder x 3",
+	"A fire starter bow and some fuel (wood).": "Ein Feuerbohrer und etwas Brennstoff (Holz).",
+
+	// text mode
+	"Text mode. Press v to show the map, ? for help.": "Textmodus. v zeigt die Karte, ? die Hilfe.",
+	"Recent events:":                     "Letzte Ereignisse:",
+	"Inventory, %d items, sorted by %s:": "Inventar, %d Gegenstände, sortiert nach %s:",
+	"%s x %d, weight %.1f":               "%s x %d, Gewicht %.1f",
+	"wielded":                            "in der Hand",
+	"worn":                               "angezogen",
+	"Recipes:":                           "Rezepte:",
+	"can craft":                          "herstellbar",
+	"Nothing else in sight.":             "Sonst ist nichts zu sehen.",
+	"You stand on %s.":                   "Du stehst auf %s.",
+	"Here: %s.":                          "Hier: %s.",
+	"Health %d of %d. %s, %s, %s, %s. Pack %.1f of %d. Wielding %s.": "Gesundheit %d von %d. %s, %s, %s, %s. Gepäck %.1f von %d. In der Hand: %s.",
+	"Working on %s, %d%% done.":                                      "Arbeit an %s, %d%% erledigt.",
+	"hostile, approaching":                                           "feindselig, nähert sich",
+	"hostile":                                                        "feindselig",
+	"fleeing":                                                        "flieht",
+	"asleep":                                                         "schläft",
+	"calm":                                                           "ruhig",
+	"East":                                                           "Osten",
+	"South-east":                                                     "Südosten",
+	"South":                                                          "Süden",
+	"South-west":                                                     "Südwesten",
+	"West":                                                           "Westen",
+	"North-west":                                                     "Nordwesten",
+	"North":                                                          "Norden",
+	"North-east":                                                     "Nordosten",
+	"the ground":                                                     "dem Boden",
+	"wall":                                                           "Wand",
+	"floor":                                                          "Boden",
+	"water":                                                          "Wasser",
+	"mud":                                                            "Schlamm",
+	"grass":                                                          "Gras",
+	"rock":                                                           "Fels",
+	"pebbles":                                                        "Kieseln",
+
+	// chat
+	"Unknown command /%s. Try /help": "Unbekannter Befehl /%s. Versuche /help",
+	"Usage: %s":                      "Verwendung: %s",
+	"Nobody called %s is online":     "Niemand namens %s ist online",
+	"You whisper to %s: %s":          "Du flüsterst %s zu: %s",
+	"%s whispers: %s":                "%s flüstert: %s",
+	"%d online":                      "%d online",
+	"You haven't muted anyone":       "Du hast niemanden stummgeschaltet",
+	"Muted: %s":                      "Stummgeschaltet: %s",
+	"You muted %s":                   "Du hast %s stummgeschaltet",
+	"You unmuted %s":                 "%s ist nicht mehr stummgeschaltet",
+	"whisper to one player":          "einem Spieler zuflüstern",
+	"describe what you're doing":     "beschreiben, was du tust",
+	"list who is online and where":   "zeigen, wer online ist und wo",
+	"hide or show a player's chat":   "Chat eines Spielers aus- oder einblenden",
+	"list chat commands":             "Chatbefehle anzeigen",
+	"say":                            "sagen",
+	"shout":                          "rufen",
+	"global":                         "global",
+	"be heard further away, at the cost of some hunger": "weiter weg gehört werden, kostet etwas Hunger",
+	"talk to everyone on the island":                    "mit allen auf der Insel sprechen",
+	"open or close the global channel (admins only)":    "globalen Kanal öffnen oder schließen (nur Admins)",
+	"Only admins can do that":                           "Das dürfen nur Admins",
+	"The global channel is open":                        "Der globale Kanal ist offen",
+	"The global channel is closed":                      "Der globale Kanal ist geschlossen",
+
+	// moderation
+	"A moderator has muted you for another %s":                        "Ein Moderator hat dich noch für %s stummgeschaltet",
+	"You're sending messages too quickly. Wait a moment":              "Du sendest zu schnell Nachrichten. Warte einen Moment",
+	"A moderator has muted you for %s":                                "Ein Moderator hat dich für %s stummgeschaltet",
+	"A moderator has unmuted you":                                     "Ein Moderator hat deine Stummschaltung aufgehoben",
+	"%s was kicked":                                                   "%s wurde rausgeworfen",
+	"%s was banned for %s":                                            "%s wurde für %s gesperrt",
+	"A moderator cleared the chat":                                    "Ein Moderator hat den Chat geleert",
+	"Nobody is called %s":                                             "Niemand heißt %s",
+	"More than one player is called %s":                               "Mehrere Spieler heißen %s",
+	"You muted %s for %s":                                             "Du hast %s für %s stummgeschaltet",
+	"%s can chat again":                                               "%s kann wieder chatten",
+	"%s is no longer banned":                                          "%s ist nicht mehr gesperrt",
+	"Only moderators can do that":                                     "Das dürfen nur Moderatoren",
+	"stop a player chatting for a while, 0 to lift (moderators only)": "einen Spieler eine Weile stummschalten, 0 hebt es auf (nur Moderatoren)",
+	"disconnect a player (moderators only)":                           "einen Spieler trennen (nur Moderatoren)",
+	"keep a player out for a while, 0 to lift (moderators only)":      "einen Spieler eine Weile sperren, 0 hebt es auf (nur Moderatoren)",
+	"clear everyone's chat (moderators only)":                         "den Chat für alle leeren (nur Moderatoren)",
+
+	// mail
+	"leave a letter for a player, even if they're away": "einem Spieler einen Brief hinterlassen, auch wenn er nicht da ist",
+	"%s's mailbox is full":                              "Der Briefkasten von %s ist voll",
+	"You sent a letter to %s":                           "Du hast %s einen Brief geschickt",
+	"You have a letter from %s. Press M to read it":     "Du hast einen Brief von %s. Drücke M, um ihn zu lesen",
+	"You have %d unread letters. Press M to read them":  "Du hast %d ungelesene Briefe. Drücke M, um sie zu lesen",
+	"Mail: %d unread":                                   "Post: %d ungelesen",
+	"read mail":                                         "Post lesen",
+	"delete letter":                                     "Brief löschen",
+	"Mailbox, %d letters. D to delete, esc to close.":   "Briefkasten, %d Briefe. D löscht, Esc schließt.",
+	"No letters.":                                       "Keine Briefe.",
+	"(unread)":                                          "(ungelesen)",
+	"From %s, %s ago":                                   "Von %s, vor %s",
+
+	// death
+	"You died":                              "Du bist gestorben",
+	"Killed by a %s":                        "Getötet von: %s",
+	"Starved to death":                      "Verhungert",
+	"Died of thirst":                        "Verdurstet",
+	"Froze to death":                        "Erfroren",
+	"You survived %d days":                  "Du hast %d Tage überlebt",
+	"Animals killed: %d":                    "Getötete Tiere: %d",
+	"Plants harvested: %d":                  "Geerntete Pflanzen: %d",
+	"Items crafted: %d":                     "Hergestellte Gegenstände: %d",
+	"Steps taken: %d":                       "Gegangene Schritte: %d",
+	"You'll remember the land you explored": "Du wirst dich an das erkundete Land erinnern",
+	"You'll forget the land you explored":   "Du wirst das erkundete Land vergessen",
+	"Press r to respawn, or ctrl+c to quit": "Drücke r, um wiederzukehren, oder ctrl+c zum Beenden",
+	"You wake on a strange shore with nothing but an empty pack": "Du erwachst an einem fremden Ufer, nur mit einem leeren Rucksack",
+	"remains of %s":                        "Überreste von %s",
+	"You took the last of %s's belongings": "Du hast den Rest der Habseligkeiten von %s genommen",
+
+	// effects
+	"Bleeding":                            "Blutung",
+	"Poisoned":                            "Vergiftet",
+	"Well fed":                            "Gut genährt",
+	"Warmed by fire":                      "Vom Feuer gewärmt",
+	"Wet":                                 "Nass",
+	"Insect bitten":                       "Insektenstiche",
+	" x%d":                                " x%d",
+	"Effects: %s.":                        "Effekte: %s.",
+	"You're bleeding!":                    "Du blutest!",
+	"Your wounds have stopped bleeding":   "Deine Wunden haben aufgehört zu bluten",
+	"You're bleeding. You lost %d health": "Du blutest. Du verlierst %d Gesundheit",
+	"Bled to death":                       "Verblutet",
+	"You've been poisoned":                "Du wurdest vergiftet",
+	"The poison has worked its way out of you":          "Das Gift hat deinen Körper verlassen",
+	"You feel sick to your stomach. You lost %d health": "Dir ist übel. Du verlierst %d Gesundheit",
+	"You're well fed":                     "Du bist gut genährt",
+	"The fire's warmth has left you":      "Die Wärme des Feuers hat dich verlassen",
+	"You're soaked through":               "Du bist durchnässt",
+	"You've dried off":                    "Du bist getrocknet",
+	"Insects from the bog are biting you": "Insekten aus dem Moor stechen dich",
+	"The bites have stopped itching":      "Die Stiche jucken nicht mehr",
+	"The bird cherries are bitter, and the stones don't agree with you": "Die Traubenkirschen sind bitter, und die Kerne bekommen dir nicht",
+
+	// skills
+	"Your %s skill is now %d": "Deine Fertigkeit %s ist jetzt %d",
+	"%s %d":                   "%s %d",
+	"%s skill":                "Fertigkeit %s",
+	"You miss the %s":         "Du verfehlst %s",
+	"Skills: %s.":             "Fertigkeiten: %s.",
+
+	// encumbrance
+	"Overloaded":                                   "Überladen",
+	"Speed: %d%%":                                  "Tempo: %d %%",
+	"Overloaded, moving at %d%% speed.":            "Überladen, du bewegst dich mit %d %% Tempo.",
+	"Moving at %d%% speed.":                        "Du bewegst dich mit %d %% Tempo.",
+	"Your pack is overloaded. You can barely move": "Dein Gepäck ist überladen. Du kannst dich kaum bewegen",
+	"Your pack is no longer overloaded":            "Dein Gepäck ist nicht mehr überladen",
+	"You're carrying too much to pick up the %s":   "Du trägst zu viel, um %s aufzuheben",
+
+	// remedies
+	"Chewed, it soothes a little. Brewed into tea, it settles the stomach": "Gekaut lindert es ein wenig. Als Tee beruhigt es den Magen",
+	"The spruce shoots are sharp and resinous":                             "Die Fichtentriebe sind herb und harzig",
+	"Eases pain. Brewed into tea, it eases it a lot more":                  "Lindert Schmerzen. Als Tee lindert es sie noch viel mehr",
+	"The catkins are bitter, and your aches fade a little":                 "Die Kätzchen sind bitter, und deine Schmerzen lassen etwas nach",
+	"Rubbed on the skin, it keeps biting insects away":                     "Auf die Haut gerieben hält es stechende Insekten fern",
+	"You rub the bog myrtle on your skin. It smells sweet and resinous":    "Du reibst den Gagelstrauch auf deine Haut. Er riecht süß und harzig",
+	"Soothes insect bites. Bound into a poultice, it stops bleeding too":   "Lindert Insektenstiche. Als Umschlag stillt es auch Blutungen",
+	"You press the willow leaves onto your bites":                          "Du drückst die Weidenblätter auf deine Stiche",
+	"Settles the stomach and draws out poison":                             "Beruhigt den Magen und zieht Gift heraus",
+	"The spruce tea is warm and settles your stomach":                      "Der Fichtentee ist warm und beruhigt deinen Magen",
+	"Dulls pain and helps wounds heal":                                     "Betäubt Schmerzen und hilft Wunden zu heilen",
+	"The willow tea is bitter, but the pain ebbs away":                     "Der Weidentee ist bitter, aber der Schmerz ebbt ab",
+	"Stops bleeding and soothes insect bites":                              "Stillt Blutungen und lindert Insektenstiche",
+	"You bind the poultice to your wounds":                                 "Du bindest den Umschlag auf deine Wunden",
+	"You try the %s, but you can't tell what it does":                      "Du probierst %s, kannst aber nicht sagen, was es bewirkt",
+	"You've worked out what the %s is good for: %s":                        "Du hast herausgefunden, wofür %s gut ist: %s",
+	"know what %s is": "%s kennen",
+	"You don't know what this does. Try it to find out": "Du weißt nicht, was das bewirkt. Probier es aus",
+	"Insect repellent":                   "Insektenschutz",
+	"Numbed":                             "Betäubt",
+	"Biting insects keep their distance": "Stechende Insekten halten Abstand",
+	"The bog myrtle's scent has faded":   "Der Duft des Gagelstrauchs ist verflogen",
+	"The willow dulls your pain":         "Die Weide betäubt deinen Schmerz",
+	"Your aches are back":                "Deine Schmerzen sind zurück",
+	"Spruce Shoots x 3 and a flask of water, brewed at a campfire":           "Fichtentriebe x 3 und eine Flasche Wasser, am Lagerfeuer aufgebrüht",
+	"Glaucous Willow Catkins x 3 and a flask of water, brewed at a campfire": "Weidenkätzchen x 3 und eine Flasche Wasser, am Lagerfeuer aufgebrüht",
+	"Halberd Leaved Willow Leaves x 4 and Twine x 1":                         "Spießweidenblätter x 4 und Schnur x 1",
+
+	// food
+	"Fresh":                          "Frisch",
+	"Stale":                          "Alt",
+	"Spoiled":                        "Verdorben",
+	"Your %s has spoiled":            "%s ist verdorben",
+	"%s, keeps about %.1f more days": "%s, hält sich noch etwa %.1f Tage",
+	"dry weather":                    "trockenes Wetter",
+	"Food poisoning":                 "Lebensmittelvergiftung",
+	"Your stomach heaves. You lost %d health":             "Dein Magen rebelliert. Du hast %d Gesundheit verloren",
+	"Died of food poisoning":                              "An einer Lebensmittelvergiftung gestorben",
+	"That food was off. You feel sick":                    "Das Essen war verdorben. Dir ist übel",
+	"Your stomach has settled":                            "Dein Magen hat sich beruhigt",
+	"You choke down the raw meat":                         "Du würgst das rohe Fleisch hinunter",
+	"The roast meat is hot and filling":                   "Das Bratenfleisch ist heiß und sättigend",
+	"The smoked meat is tough, but it fills you up":       "Das Räucherfleisch ist zäh, aber es macht satt",
+	"You chew a handful of dried cloudberries":            "Du kaust eine Handvoll getrockneter Moltebeeren",
+	"Baking took the bitterness out of the bird cherries": "Das Backen hat den Traubenkirschen die Bitterkeit genommen",
+	"Raw meat x 1, cooked at a campfire":                  "Rohes Fleisch x 1, am Lagerfeuer gegart",
+	"Raw meat x 2 and some fuel, smoked over a campfire":  "Rohes Fleisch x 2 und etwas Brennstoff, über dem Lagerfeuer geräuchert",
+	"Cloudberries x 5, spread out to dry on a dry day":    "Moltebeeren x 5, an einem trockenen Tag zum Trocknen ausgelegt",
+	"Bird Cherries x 4, baked at a campfire":              "Traubenkirschen x 4, am Lagerfeuer gebacken",
+
+	// names
+	"bare hands":                   "bloße Hände",
+	"sharp rock":                   "scharfer Stein",
+	"dried leaves":                 "getrocknete Blätter",
+	"twine":                        "Schnur",
+	"fire starter bow":             "Feuerbohrer",
+	"bark flask":                   "Rindenflasche",
+	"flask of water":               "Flasche Wasser",
+	"flask of bog water":           "Flasche Moorwasser",
+	"birch bark shoes":             "Birkenrindenschuhe",
+	"bark cloak":                   "Rindenumhang",
+	"bedroll":                      "Schlafmatte",
+	"remains":                      "Überreste",
+	"Woodcutting":                  "Holzfällen",
+	"Foraging":                     "Sammeln",
+	"Crafting":                     "Handwerk",
+	"Combat":                       "Kampf",
+	"Fire-making":                  "Feuermachen",
+	"flask of spruce tea":          "Flasche Fichtentee",
+	"flask of willow tea":          "Flasche Weidentee",
+	"willow poultice":              "Weidenumschlag",
+	"raw meat":                     "rohes Fleisch",
+	"roast meat":                   "Bratenfleisch",
+	"smoked meat":                  "Räucherfleisch",
+	"dried cloudberries":           "getrocknete Moltebeeren",
+	"baked bird cherries":          "gebackene Traubenkirschen",
+	"Campfire":                     "Lagerfeuer",
+	"rabbit":                       "Hase",
+	"brown bear":                   "Braunbär",
+	"Scots Pine":                   "Waldkiefer",
+	"Pine Bark":                    "Kiefernrinde",
+	"Pine Wood":                    "Kiefernholz",
+	"Norway Spruce":                "Gemeine Fichte",
+	"Spruce Wood":                  "Fichtenholz",
+	"Spruce Shoots":                "Fichtentriebe",
+	"Aspen":                        "Zitterpappel",
+	"Aspen Wood":                   "Pappelholz",
+	"Aspen Bark":                   "Pappelrinde",
+	"Grey Adler":                   "Grauerle",
+	"Grey Adler Wood":              "Grauerlenholz",
+	"Grey Adler Bark":              "Grauerlenrinde",
+	"Bird Cherry":                  "Traubenkirsche",
+	"Bird Cherry Wood":             "Traubenkirschenholz",
+	"Bird Cherries":                "Traubenkirschen",
+	"Downy Birch":                  "Moorbirke",
+	"Downy Birch Wood":             "Moorbirkenholz",
+	"Downy Birch Bark":             "Moorbirkenrinde",
+	"Downy Birch Branches":         "Moorbirkenzweige",
+	"Bog Myrtle":                   "Gagelstrauch",
+	"Bog Myrtle Leaves":            "Gagelblätter",
+	"Goat Willow":                  "Salweide",
+	"Goat Willow Stalks":           "Salweidenruten",
+	"Glaucous Willow":              "Blaugrüne Weide",
+	"Glaucous Willow Catkins":      "Weidenkätzchen",
+	"Halberd-leaved Willow":        "Spießblättrige Weide",
+	"Halberd Leaved Willow Sticks": "Spießweidenstöcke",
+	"Halberd Leaved Willow Leaves": "Spießweidenblätter",
+	"Cloudberry Bush":              "Moltebeerenstrauch",
+	"Cloudberries":                 "Moltebeeren",
+}
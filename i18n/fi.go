@@ -0,0 +1,442 @@
+package i18n
+
+var finnish = map[string]string{
+	// events
+	"%s joined.":                                  "%s liittyi.",
+	"%s left.":                                    "%s lähti.",
+	"RIP %s":                                      "Lepää rauhassa, %s",
+	"%s washed ashore.":                           "%s ajautui rantaan.",
+	"You killed the %s":                           "Kaadoit: %s",
+	"It dropped %d x %s":                          "Siitä jäi %d x %s",
+	"Your %s doesn't do anything to the %s":       "Väline %s ei tehoa: %s",
+	"You hit the %s for %d":                       "Osuit: %s, vahinko %d",
+	"It yielded %d x %s":                          "Saalis: %d x %s",
+	"You harvested the %s":                        "Korjasit: %s",
+	"Your %s does not work here":                  "Väline %s ei toimi tässä",
+	"There's no room to drop that here":           "Tässä ei ole tilaa pudottaa sitä",
+	"You dropped %d x %s":                         "Pudotit %d x %s",
+	"You crafted a %s":                            "Valmistit: %s",
+	"You can't craft a %s yet":                    "Et voi vielä valmistaa: %s",
+	"You picked up %d x %s":                       "Poimit %d x %s",
+	"You put away the %s":                         "Laitoit pois: %s",
+	"Now wielding: %s":                            "Kädessä: %s",
+	"The %s attacked you! You lost %d health":     "Kimppuusi kävi %s! Menetit %d terveyttä",
+	"The %s missed!":                              "%s ei osunut!",
+	"You ate a handful of delicious cloudberries": "Söit kourallisen herkullisia lakkoja",
+
+	// status and sidebar
+	"%s : Year %d, Day %d":   "%s : Vuosi %d, Päivä %d",
+	"Location : %d, %d":      "Sijainti : %d, %d",
+	"Pack: %.1f / %d":        "Reppu: %.1f / %d",
+	"Health: %d / %d":        "Terveys: %d / %d",
+	"Hunger: %s":             "Nälkä: %s",
+	"Fed":                    "Kylläinen",
+	"Peckish":                "Nälkäinen",
+	"Hungry":                 "Nälissään",
+	"Starving":               "Nääntymässä",
+	"You're getting peckish": "Alkaa olla nälkä",
+	"You're hungry. You move and work more slowly":       "Olet nälissäsi. Liikut ja työskentelet hitaammin",
+	"You're starving! Eat something before it kills you": "Olet nääntymässä! Syö jotain ennen kuin nälkä tappaa sinut",
+	"You are starving. You lost %d health":               "Näännyt nälkään. Menetit %d terveyttä",
+	"Thirst: %s":                                         "Jano: %s",
+	"Quenched":                                           "Janoton",
+	"Thirsty":                                            "Janoinen",
+	"Parched":                                            "Nääntynyt janoon",
+	"Dehydrated":                                         "Kuivumassa",
+	"You're thirsty":                                     "Sinulla on jano",
+	"You're parched. You move and work more slowly":     "Olet janoissasi. Liikut ja työskentelet hitaammin",
+	"You're dehydrated! Find water before it kills you": "Olet kuivumassa! Etsi vettä ennen kuin jano tappaa sinut",
+	"You are dehydrated. You lost %d health":            "Olet kuivumassa. Menetit %d terveyttä",
+	"You drink the brackish bog water":                  "Juot suon sameaa vettä",
+	"You drink your fill of cold, clear water":          "Juot kylmää, kirkasta vettä kyllikseen",
+	"You filled a %s": "Täytit: %s",
+	"The bog water makes you sick. You lost %d health": "Suovesi sairastutti sinut. Menetit %d terveyttä",
+	"You drink from the %s":                            "Juot: %s",
+	"Warmth: %s":                                       "Lämpö: %s",
+	"Warm":                                             "Lämmin",
+	"Cold":                                             "Kylmissään",
+	"Hypothermic":                                      "Alilämpöinen",
+	"Freezing":                                         "Jäätymässä",
+	"You're getting cold":                              "Sinulla alkaa olla kylmä",
+	"You're hypothermic. You move and work more slowly":  "Olet alilämpöinen. Liikut ja työskentelet hitaammin",
+	"You're freezing to death! Get to a fire or shelter": "Olet jäätymässä kuoliaaksi! Mene nuotiolle tai suojaan",
+	"You are freezing. You lost %d health":               "Olet jäätymässä. Menetit %d terveyttä",
+	"%s, %d°C":                                           "%s, %d°C",
+	"Clear":                                              "Selkeää",
+	"Cloudy":                                             "Pilvistä",
+	"Rain":                                               "Sadetta",
+	"Storm":                                              "Myrsky",
+	"Snow":                                               "Lumisadetta",
+	"The sky clears":                                     "Taivas seestyy",
+	"Clouds roll in":                                     "Pilvet vyöryvät esiin",
+	"It starts to rain":                                  "Alkaa sataa",
+	"A storm is coming":                                  "Myrsky on tulossa",
+	"It starts to snow":                                  "Alkaa sataa lunta",
+	"Fatigue: %s":                                        "Väsymys: %s",
+	"Rested":                                             "Levännyt",
+	"Tired":                                              "Väsynyt",
+	"Weary":                                              "Uupunut",
+	"Exhausted":                                          "Lopen uupunut",
+	"You're getting tired":                               "Alat väsyä",
+	"You're weary. You move and work more slowly":     "Olet uupunut. Liikut ja työskentelet hitaammin",
+	"You're exhausted! Rest or sleep before you drop": "Olet lopen uupunut! Lepää tai nuku ennen kuin lyyhistyt",
+	"Resting":            "Lepäämässä",
+	"Sleeping":           "Nukkumassa",
+	"%s.":                "%s.",
+	"You wake up rested": "Heräät levänneenä",
+	"Something dangerous is close. You get up":    "Jokin vaarallinen on lähellä. Nouset ylös",
+	"The %s is on you! You scramble to your feet": "%s hyökkää kimppuusi! Ponkaiset jaloillesi",
+	"You get up":                             "Nouset ylös",
+	"You can't rest with danger nearby":      "Et voi levätä vaaran ollessa lähellä",
+	"You sit down to rest":                   "Istahdat lepäämään",
+	"You can't sleep with danger nearby":     "Et voi nukkua vaaran ollessa lähellä",
+	"You need a bedroll or shelter to sleep": "Tarvitset makuualustan tai suojan nukkuaksesi",
+	"You aren't tired enough to sleep":       "Et ole tarpeeksi väsynyt nukkuaksesi",
+	"You lie down and fall asleep":           "Käyt makuulle ja nukahdat",
+	"You put on the %s":                      "Puit päällesi: %s",
+	"You take off the %s":                    "Riisuit: %s",
+	"Spring":                                 "Kevät",
+	"Summer":                                 "Kesä",
+	"Fall":                                   "Syksy",
+	"Winter":                                 "Talvi",
+	"%s has begun":                           "%s on alkanut",
+
+	// layout and help
+	"Map":                        "Kartta",
+	"Status":                     "Tila",
+	"Chat":                       "Keskustelu",
+	"(tab to switch)":            "(vaihda tabulaattorilla)",
+	"terminal too small":         "pääte on liian pieni",
+	"%dx%d, need at least %dx%d": "%dx%d, vähintään %dx%d tarvitaan",
+	"chat":                       "keskustelu",
+	"search":                     "haku",
+	"move up":                    "liiku ylös",
+	"move down":                  "liiku alas",
+	"move left":                  "liiku vasemmalle",
+	"move right":                 "liiku oikealle",
+	"move northwest":             "liiku luoteeseen",
+	"move northeast":             "liiku koilliseen",
+	"move southwest":             "liiku lounaaseen",
+	"move southeast":             "liiku kaakkoon",
+	"toggle help":                "ohje päälle/pois",
+	"focus chat input":           "kirjoita keskusteluun",
+	"show inventory browser":     "näytä tavarat",
+	"show message log":           "näytä viestiloki",
+	"toggle topic":               "aihe päälle/pois",
+	"cycle event timestamps":     "vaihda aikaleimoja",
+	"toggle text mode":           "tekstitila päälle/pois",
+	"change language":            "vaihda kieltä",
+	"rest":                       "lepää",
+	"sleep":                      "nuku",
+	"respawn":                    "herää henkiin",
+	"drop one":                   "pudota yksi",
+	"drop some":                  "pudota useita",
+	"drop all":                   "pudota kaikki",
+	"change sort":                "vaihda järjestystä",
+	"quit":                       "lopeta",
+	"combat":                     "taistelu",
+	"harvesting":                 "sadonkorjuu",
+	"crafting":                   "valmistus",
+	"system":                     "järjestelmä",
+
+	// inventory and crafting
+	"Inventory":                           "Tavarat",
+	"Item":                                "Esine",
+	"Qty":                                 "Määrä",
+	"Weight":                              "Paino",
+	"Crafting Recipes":                    "Valmistusohjeet",
+	"recipe":                              "ohje",
+	"recipes":                             "ohjetta",
+	"needs %s":                            "tarvitaan %s",
+	"near a %s":                           "lähellä: %s",
+	"wielding a %s":                       "kädessä: %s",
+	"by %s · x/n/X drop":                  "järjestys: %s · x/n/X pudota",
+	"drop how many?":                      "montako pudotetaan?",
+	"wielding":                            "kädessä",
+	"%s, %.2f each, %.1f total":           "%s, %.2f kpl, yhteensä %.1f",
+	"Traits: %s":                          "Ominaisuudet: %s",
+	"enter to use":                        "käytä enterillä",
+	"name":                                "nimi",
+	"weight":                              "paino",
+	"quantity":                            "määrä",
+	"category":                            "luokka",
+	"Food":                                "Ruoka",
+	"Tool":                                "Työkalu",
+	"Fuel":                                "Polttoaine",
+	"Material":                            "Materiaali",
+	"Weapon":                              "Ase",
+	"Digger":                              "Kaivin",
+	"Axe":                                 "Kirves",
+	"Knife":                               "Veitsi",
+	"Kindling":                            "Sytyke",
+	"Edible":                              "Syötävä",
+	"Sticks":                              "Tikut",
+	"Clothing":                            "Vaatteet",
+	"wearing":                             "päällä",
+	"Twine x 3, Sticks x 3, Kindling x 3": "Naru x 3, Tikut x 3, Sytyke x 3",
+	"A fire starter bow and some fuel (wood).": "Tulijousi ja vähän polttoainetta (puuta).",
+
+	// text mode
+	"Text mode. Press v to show the map, ? for help.": "Tekstitila. v näyttää kartan, ? ohjeen.",
+	"Recent events:":                     "Viimeisimmät tapahtumat:",
+	"Inventory, %d items, sorted by %s:": "Tavarat, %d esinettä, järjestys: %s:",
+	"%s x %d, weight %.1f":               "%s x %d, paino %.1f",
+	"wielded":                            "kädessä",
+	"worn":                               "päällä",
+	"Recipes:":                           "Valmistusohjeet:",
+	"can craft":                          "voi valmistaa",
+	"Nothing else in sight.":             "Muuta ei näy.",
+	"You stand on %s.":                   "Alustasi: %s.",
+	"Here: %s.":                          "Tässä: %s.",
+	"Health %d of %d. %s, %s, %s, %s. Pack %.1f of %d. Wielding %s.": "Terveys %d/%d. %s, %s, %s, %s. Reppu %.1f/%d. Kädessä: %s.",
+	"Working on %s, %d%% done.":                                      "Työn alla: %s, %d%% valmis.",
+	"hostile, approaching":                                           "vihamielinen, lähestyy",
+	"hostile":                                                        "vihamielinen",
+	"fleeing":                                                        "pakenee",
+	"asleep":                                                         "nukkuu",
+	"calm":                                                           "rauhallinen",
+	"East":                                                           "Itä",
+	"South-east":                                                     "Kaakko",
+	"South":                                                          "Etelä",
+	"South-west":                                                     "Lounas",
+	"West":                                                           "Länsi",
+	"North-west":                                                     "Luode",
+	"North":                                                          "Pohjoinen",
+	"North-east":                                                     "Koillinen",
+	"the ground":                                                     "maa",
+	"wall":                                                           "seinä",
+	"floor":                                                          "lattia",
+	"water":                                                          "vesi",
+	"mud":                                                            "muta",
+	"grass":                                                          "ruoho",
+	"rock":                                                           "kallio",
+	"pebbles":                                                        "pikkukivet",
+
+	// chat
+	"Unknown command /%s. Try /help": "Tuntematon komento /%s. Kokeile /help",
+	"Usage: %s":                      "Käyttö: %s",
+	"Nobody called %s is online":     "Kukaan nimeltä %s ei ole paikalla",
+	"You whisper to %s: %s":          "Kuiskaat pelaajalle %s: %s",
+	"%s whispers: %s":                "%s kuiskaa: %s",
+	"%d online":                      "%d paikalla",
+	"You haven't muted anyone":       "Et ole mykistänyt ketään",
+	"Muted: %s":                      "Mykistetty: %s",
+	"You muted %s":                   "Mykistit pelaajan %s",
+	"You unmuted %s":                 "Poistit mykistyksen: %s",
+	"whisper to one player":          "kuiskaa yhdelle pelaajalle",
+	"describe what you're doing":     "kerro mitä teet",
+	"list who is online and where":   "näytä ketkä ovat paikalla ja missä",
+	"hide or show a player's chat":   "piilota tai näytä pelaajan viestit",
+	"list chat commands":             "näytä keskustelukomennot",
+	"say":                            "puhe",
+	"shout":                          "huuto",
+	"global":                         "kaikki",
+	"be heard further away, at the cost of some hunger": "kuulu kauemmas, vähän nälän hinnalla",
+	"talk to everyone on the island":                    "puhu kaikille saarella",
+	"open or close the global channel (admins only)":    "avaa tai sulje yhteinen kanava (vain ylläpitäjät)",
+	"Only admins can do that":                           "Vain ylläpitäjät voivat tehdä sen",
+	"The global channel is open":                        "Yhteinen kanava on auki",
+	"The global channel is closed":                      "Yhteinen kanava on suljettu",
+
+	// moderation
+	"A moderator has muted you for another %s":                        "Valvoja on mykistänyt sinut vielä %s ajaksi",
+	"You're sending messages too quickly. Wait a moment":              "Lähetät viestejä liian nopeasti. Odota hetki",
+	"A moderator has muted you for %s":                                "Valvoja mykisti sinut %s ajaksi",
+	"A moderator has unmuted you":                                     "Valvoja poisti mykistyksesi",
+	"%s was kicked":                                                   "%s potkittiin ulos",
+	"%s was banned for %s":                                            "%s sai porttikiellon %s ajaksi",
+	"A moderator cleared the chat":                                    "Valvoja tyhjensi keskustelun",
+	"Nobody is called %s":                                             "Kukaan ei ole nimeltään %s",
+	"More than one player is called %s":                               "Useampi pelaaja on nimeltään %s",
+	"You muted %s for %s":                                             "Mykistit pelaajan %s %s ajaksi",
+	"%s can chat again":                                               "%s voi taas keskustella",
+	"%s is no longer banned":                                          "Pelaajan %s porttikielto on poistettu",
+	"Only moderators can do that":                                     "Vain valvojat voivat tehdä sen",
+	"stop a player chatting for a while, 0 to lift (moderators only)": "estä pelaajaa keskustelemasta hetkeksi, 0 poistaa (vain valvojat)",
+	"disconnect a player (moderators only)":                           "katkaise pelaajan yhteys (vain valvojat)",
+	"keep a player out for a while, 0 to lift (moderators only)":      "pidä pelaaja poissa hetken, 0 poistaa (vain valvojat)",
+	"clear everyone's chat (moderators only)":                         "tyhjennä kaikkien keskustelu (vain valvojat)",
+
+	// mail
+	"leave a letter for a player, even if they're away": "jätä kirje pelaajalle, vaikka hän olisi poissa",
+	"%s's mailbox is full":                              "Pelaajan %s postilaatikko on täynnä",
+	"You sent a letter to %s":                           "Lähetit kirjeen pelaajalle %s",
+	"You have a letter from %s. Press M to read it":     "Sait kirjeen pelaajalta %s. Lue se painamalla M",
+	"You have %d unread letters. Press M to read them":  "Sinulla on %d lukematonta kirjettä. Lue ne painamalla M",
+	"Mail: %d unread":                                   "Posti: %d lukematonta",
+	"read mail":                                         "lue posti",
+	"delete letter":                                     "poista kirje",
+	"Mailbox, %d letters. D to delete, esc to close.":   "Postilaatikko, %d kirjettä. D poistaa, esc sulkee.",
+	"No letters.":                                       "Ei kirjeitä.",
+	"(unread)":                                          "(lukematon)",
+	"From %s, %s ago":                                   "Lähettäjä %s, %s sitten",
+
+	// death
+	"You died":                              "Kuolit",
+	"Killed by a %s":                        "Tappaja: %s",
+	"Starved to death":                      "Nääntyi nälkään",
+	"Died of thirst":                        "Kuoli janoon",
+	"Froze to death":                        "Paleltui kuoliaaksi",
+	"You survived %d days":                  "Selvisit %d päivää",
+	"Animals killed: %d":                    "Tapettuja eläimiä: %d",
+	"Plants harvested: %d":                  "Korjattuja kasveja: %d",
+	"Items crafted: %d":                     "Valmistettuja esineitä: %d",
+	"Steps taken: %d":                       "Otettuja askelia: %d",
+	"You'll remember the land you explored": "Muistat tutkimasi maat",
+	"You'll forget the land you explored":   "Unohdat tutkimasi maat",
+	"Press r to respawn, or ctrl+c to quit": "Paina r herätäksesi henkiin tai ctrl+c lopettaaksesi",
+	"You wake on a strange shore with nothing but an empty pack": "Heräät oudolta rannalta, mukanasi vain tyhjä reppu",
+	"remains of %s":                        "Pelaajan %s jäännökset",
+	"You took the last of %s's belongings": "Otit viimeisetkin pelaajan %s tavaroista",
+
+	// effects
+	"Bleeding":                            "Verenvuoto",
+	"Poisoned":                            "Myrkytys",
+	"Well fed":                            "Kylläinen olo",
+	"Warmed by fire":                      "Tulen lämmittämä",
+	"Wet":                                 "Märkä",
+	"Insect bitten":                       "Hyönteisten puremia",
+	" x%d":                                " x%d",
+	"Effects: %s.":                        "Vaikutukset: %s.",
+	"You're bleeding!":                    "Vuodat verta!",
+	"Your wounds have stopped bleeding":   "Haavasi ovat lakanneet vuotamasta",
+	"You're bleeding. You lost %d health": "Vuodat verta. Menetit %d terveyttä",
+	"Bled to death":                       "Vuoti kuiviin",
+	"You've been poisoned":                "Olet myrkyttynyt",
+	"The poison has worked its way out of you":          "Myrkky on poistunut elimistöstäsi",
+	"You feel sick to your stomach. You lost %d health": "Vatsaasi kouristaa. Menetit %d terveyttä",
+	"You're well fed":                     "Olet syönyt hyvin",
+	"The fire's warmth has left you":      "Tulen lämpö on haihtunut",
+	"You're soaked through":               "Olet läpimärkä",
+	"You've dried off":                    "Olet kuivunut",
+	"Insects from the bog are biting you": "Suon hyönteiset purevat sinua",
+	"The bites have stopped itching":      "Puremat ovat lakanneet kutisemasta",
+	"The bird cherries are bitter, and the stones don't agree with you": "Tuomenmarjat ovat kitkeriä, eivätkä kivet sovi vatsallesi",
+
+	// skills
+	"Your %s skill is now %d": "%s-taitosi on nyt %d",
+	"%s %d":                   "%s %d",
+	"%s skill":                "%s-taito",
+	"You miss the %s":         "Huti: %s",
+	"Skills: %s.":             "Taidot: %s.",
+
+	// encumbrance
+	"Overloaded":                                   "Ylikuormitettu",
+	"Speed: %d%%":                                  "Nopeus: %d %%",
+	"Overloaded, moving at %d%% speed.":            "Ylikuormitettu, liikut %d %% nopeudella.",
+	"Moving at %d%% speed.":                        "Liikut %d %% nopeudella.",
+	"Your pack is overloaded. You can barely move": "Reppusi on ylikuormitettu. Tuskin pystyt liikkumaan",
+	"Your pack is no longer overloaded":            "Reppusi ei ole enää ylikuormitettu",
+	"You're carrying too much to pick up the %s":   "Kannat liikaa, et jaksa ottaa: %s",
+
+	// remedies
+	"Chewed, it soothes a little. Brewed into tea, it settles the stomach": "Pureskeltynä se lievittää hieman. Teenä se rauhoittaa vatsaa",
+	"The spruce shoots are sharp and resinous":                             "Kuusenkerkät ovat kirpeitä ja pihkaisia",
+	"Eases pain. Brewed into tea, it eases it a lot more":                  "Lievittää kipua. Teenä se lievittää paljon enemmän",
+	"The catkins are bitter, and your aches fade a little":                 "Norkot ovat kitkeriä, ja särkysi hellittää hieman",
+	"Rubbed on the skin, it keeps biting insects away":                     "Iholle hierottuna se pitää pistävät hyönteiset loitolla",
+	"You rub the bog myrtle on your skin. It smells sweet and resinous":    "Hierot suomyrttiä ihollesi. Se tuoksuu makealta ja pihkaiselta",
+	"Soothes insect bites. Bound into a poultice, it stops bleeding too":   "Lievittää hyönteisten puremia. Hauteena se tyrehdyttää myös verenvuodon",
+	"You press the willow leaves onto your bites":                          "Painat pajunlehtiä puremiisi",
+	"Settles the stomach and draws out poison":                             "Rauhoittaa vatsaa ja poistaa myrkkyä",
+	"The spruce tea is warm and settles your stomach":                      "Kuusenkerkkätee on lämmintä ja rauhoittaa vatsaasi",
+	"Dulls pain and helps wounds heal":                                     "Turruttaa kivun ja auttaa haavoja paranemaan",
+	"The willow tea is bitter, but the pain ebbs away":                     "Pajutee on kitkerää, mutta kipu hellittää",
+	"Stops bleeding and soothes insect bites":                              "Tyrehdyttää verenvuodon ja lievittää hyönteisten puremia",
+	"You bind the poultice to your wounds":                                 "Sidot hauteen haavoihisi",
+	"You try the %s, but you can't tell what it does":                      "Kokeilet: %s, mutta et tiedä, mitä se tekee",
+	"You've worked out what the %s is good for: %s":                        "Olet selvittänyt, mihin %s on hyväksi: %s",
+	"know what %s is": "tunnista %s",
+	"You don't know what this does. Try it to find out": "Et tiedä, mitä tämä tekee. Kokeile ja ota selvää",
+	"Insect repellent":                   "Hyönteiskarkote",
+	"Numbed":                             "Turtunut",
+	"Biting insects keep their distance": "Pistävät hyönteiset pysyvät loitolla",
+	"The bog myrtle's scent has faded":   "Suomyrtin tuoksu on haihtunut",
+	"The willow dulls your pain":         "Paju turruttaa kipusi",
+	"Your aches are back":                "Särky palasi",
+	"Spruce Shoots x 3 and a flask of water, brewed at a campfire":           "Kuusenkerkät x 3 ja leili vettä, haudutettuna nuotiolla",
+	"Glaucous Willow Catkins x 3 and a flask of water, brewed at a campfire": "Sinipajun norkot x 3 ja leili vettä, haudutettuna nuotiolla",
+	"Halberd Leaved Willow Leaves x 4 and Twine x 1":                         "Kalvaspajun lehdet x 4 ja naru x 1",
+
+	// food
+	"Fresh":                          "Tuore",
+	"Stale":                          "Vanhentunut",
+	"Spoiled":                        "Pilaantunut",
+	"Your %s has spoiled":            "%s on pilaantunut",
+	"%s, keeps about %.1f more days": "%s, säilyy vielä noin %.1f päivää",
+	"dry weather":                    "pouta",
+	"Food poisoning":                 "Ruokamyrkytys",
+	"Your stomach heaves. You lost %d health":             "Vatsasi kääntyy. Menetit %d terveyttä",
+	"Died of food poisoning":                              "Kuoli ruokamyrkytykseen",
+	"That food was off. You feel sick":                    "Ruoka oli pilaantunutta. Voit pahoin",
+	"Your stomach has settled":                            "Vatsasi on rauhoittunut",
+	"You choke down the raw meat":                         "Nielet raa'an lihan väkisin",
+	"The roast meat is hot and filling":                   "Paistettu liha on kuumaa ja täyttävää",
+	"The smoked meat is tough, but it fills you up":       "Savuliha on sitkeää, mutta täyttää vatsan",
+	"You chew a handful of dried cloudberries":            "Pureskelet kourallisen kuivattuja lakkoja",
+	"Baking took the bitterness out of the bird cherries": "Paistaminen vei tuomenmarjoista kitkeryyden",
+	"Raw meat x 1, cooked at a campfire":                  "Raaka liha x 1, kypsennettynä nuotiolla",
+	"Raw meat x 2 and some fuel, smoked over a campfire":  "Raaka liha x 2 ja polttoainetta, savustettuna nuotiolla",
+	"Cloudberries x 5, spread out to dry on a dry day":    "Lakat x 5, levitettynä kuivumaan poutapäivänä",
+	"Bird Cherries x 4, baked at a campfire":              "Tuomenmarjat x 4, paistettuna nuotiolla",
+
+	// names
+	"bare hands":                   "paljaat kädet",
+	"sharp rock":                   "terävä kivi",
+	"dried leaves":                 "kuivatut lehdet",
+	"twine":                        "naru",
+	"fire starter bow":             "tulijousi",
+	"bark flask":                   "tuohileili",
+	"flask of water":               "leili vettä",
+	"flask of bog water":           "leili suovettä",
+	"birch bark shoes":             "tuohivirsut",
+	"bark cloak":                   "kaarnaviitta",
+	"bedroll":                      "makuualusta",
+	"remains":                      "jäännökset",
+	"Woodcutting":                  "Puunkaato",
+	"Foraging":                     "Keräily",
+	"Crafting":                     "Käsityö",
+	"Combat":                       "Taistelu",
+	"Fire-making":                  "Tulenteko",
+	"flask of spruce tea":          "leili kuusenkerkkäteetä",
+	"flask of willow tea":          "leili pajuteetä",
+	"willow poultice":              "pajuhaude",
+	"raw meat":                     "raaka liha",
+	"roast meat":                   "paistettu liha",
+	"smoked meat":                  "savuliha",
+	"dried cloudberries":           "kuivatut lakat",
+	"baked bird cherries":          "paistetut tuomenmarjat",
+	"Campfire":                     "Nuotio",
+	"rabbit":                       "jänis",
+	"brown bear":                   "karhu",
+	"Scots Pine":                   "Mänty",
+	"Pine Bark":                    "Männyn kaarna",
+	"Pine Wood":                    "Männyn puu",
+	"Norway Spruce":                "Kuusi",
+	"Spruce Wood":                  "Kuusen puu",
+	"Spruce Shoots":                "Kuusenkerkät",
+	"Aspen":                        "Haapa",
+	"Aspen Wood":                   "Haavan puu",
+	"Aspen Bark":                   "Haavan kaarna",
+	"Grey Adler":                   "Harmaaleppä",
+	"Grey Adler Wood":              "Harmaalepän puu",
+	"Grey Adler Bark":              "Harmaalepän kaarna",
+	"Bird Cherry":                  "Tuomi",
+	"Bird Cherry Wood":             "Tuomen puu",
+	"Bird Cherries":                "Tuomenmarjat",
+	"Downy Birch":                  "Hieskoivu",
+	"Downy Birch Wood":             "Hieskoivun puu",
+	"Downy Birch Bark":             "Tuohi",
+	"Downy Birch Branches":         "Hieskoivun oksat",
+	"Bog Myrtle":                   "Suomyrtti",
+	"Bog Myrtle Leaves":            "Suomyrtin lehdet",
+	"Goat Willow":                  "Raita",
+	"Goat Willow Stalks":           "Raidan varret",
+	"Glaucous Willow":              "Sinipaju",
+	"Glaucous Willow Catkins":      "Sinipajun norkot",
+	"Halberd-leaved Willow":        "Kalvaspaju",
+	"Halberd Leaved Willow Sticks": "Kalvaspajun tikut",
+	"Halberd Leaved Willow Leaves": "Kalvaspajun lehdet",
+	"Cloudberry Bush":              "Lakkamätäs",
+	"Cloudberries":                 "Lakat",
+}
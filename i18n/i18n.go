@@ -0,0 +1,108 @@
+package i18n
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Locale is a language that player-facing text can be shown in
+type Locale string
+
+const (
+	English Locale = "en"
+	Finnish Locale = "fi"
+	German  Locale = "de"
+)
+
+var Locales = []Locale{English, Finnish, German}
+
+var localeNames = map[Locale]string{
+	English: "English",
+	Finnish: "Suomi",
+	German:  "Deutsch",
+}
+
+// catalogs map English text (the source language) to its translation. text that is missing from a
+// catalog falls back to English.
+var catalogs = map[Locale]map[string]string{
+	Finnish: finnish,
+	German:  german,
+}
+
+func (l Locale) String() string {
+	if n, ok := localeNames[l]; ok {
+		return n
+	}
+	return localeNames[English]
+}
+
+// Next cycles through the available locales
+func (l Locale) Next() Locale {
+	for i, ll := range Locales {
+		if ll == l {
+			return Locales[(i+1)%len(Locales)]
+		}
+	}
+	return English
+}
+
+// Parse picks a locale from a POSIX locale string such as "fi_FI.UTF-8", falling back to English
+func Parse(s string) Locale {
+	s = strings.ToLower(s)
+	for _, l := range Locales {
+		if strings.HasPrefix(s, string(l)) {
+			return l
+		}
+	}
+	return English
+}
+
+// T translates format and renders it with args, like fmt.Sprintf. args of type Name are translated too.
+// translations may reorder args with explicit indexes, eg "%[2]s ... %[1]d".
+func (l Locale) T(format string, args ...interface{}) string {
+	if t, ok := catalogs[l][format]; ok {
+		format = t
+	}
+	if len(args) == 0 {
+		return format
+	}
+	translated := make([]interface{}, len(args))
+	for i, a := range args {
+		if n, ok := a.(Name); ok {
+			translated[i] = l.T(string(n))
+		} else {
+			translated[i] = a
+		}
+	}
+	return fmt.Sprintf(format, translated...)
+}
+
+// Name is a message argument that is translated along with the message, eg an item or flora name
+type Name string
+
+// Message is player-facing text that is translated when it's displayed, so that each reader sees it
+// in their own locale
+type Message struct {
+	format string
+	args   []interface{}
+}
+
+// M builds a Message. format is English, and is also the key used to look up translations.
+func M(format string, args ...interface{}) Message {
+	return Message{format: format, args: args}
+}
+
+// Literal is a Message that is never translated, like a player's chat
+func Literal(s string) Message {
+	return Message{format: "%s", args: []interface{}{s}}
+}
+
+// In renders the message in the given locale
+func (m Message) In(l Locale) string {
+	return l.T(m.format, m.args...)
+}
+
+// String renders the message in English
+func (m Message) String() string {
+	return m.In(English)
+}
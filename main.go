@@ -2,17 +2,48 @@ package main
 
 import (
 	"fmt"
+	"github.com/dustmason/nicefort/events"
 	"github.com/dustmason/nicefort/server"
 	"github.com/dustmason/nicefort/world"
+	"log"
 	"net/http"
 	_ "net/http/pprof"
+	"os"
+	"strconv"
+	"strings"
 )
 
+const eventLogMaxSize = 10 << 20 // bytes before the event log is rotated
+const eventLogKeep = 5           // number of rotated event logs kept
+
 func main() {
 	go func() {
 		fmt.Println(http.ListenAndServe(":6060", nil))
 	}()
 	w := world.NewWorld(600)
+	w.SetAdmins(strings.Split(os.Getenv("NICEFORT_ADMINS"), ","))
+	w.SetModerators(strings.Split(os.Getenv("NICEFORT_MODERATORS"), ","))
+	w.SetChatFilter(strings.Split(os.Getenv("NICEFORT_CHAT_FILTER"), ","))
+	keepMap, _ := strconv.ParseBool(os.Getenv("NICEFORT_KEEP_MAP_MEMORY"))
+	w.SetKeepMapMemory(keepMap)
+	eventLog, err := events.NewJSONLog(eventLogPath(), eventLogMaxSize, eventLogKeep)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	unsubscribe := w.Bus().Subscribe(eventLog.Handle)
 	s := server.NewServer(w)
 	s.Listen()
+	// the world keeps ticking and publishing, so stop sending it events before closing the log
+	unsubscribe()
+	if err := eventLog.Close(); err != nil {
+		log.Println(err)
+	}
+}
+
+// eventLogPath is where world events are logged, set with NICEFORT_EVENT_LOG
+func eventLogPath() string {
+	if p := os.Getenv("NICEFORT_EVENT_LOG"); p != "" {
+		return p
+	}
+	return "events.jsonl"
 }
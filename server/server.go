@@ -7,6 +7,7 @@ import (
 	"github.com/charmbracelet/wish"
 	bm "github.com/charmbracelet/wish/bubbletea"
 	lm "github.com/charmbracelet/wish/logging"
+	"github.com/dustmason/nicefort/i18n"
 	"github.com/dustmason/nicefort/ui"
 	"github.com/dustmason/nicefort/world"
 	"github.com/gliderlabs/ssh"
@@ -15,6 +16,7 @@ import (
 	"log"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 )
@@ -34,7 +36,7 @@ func NewServer(w *world.World) *Server {
 		wish.WithAddress(fmt.Sprintf("%s:%d", host, port)),
 		wish.WithHostKeyPath(".ssh/term_info_ed25519"),
 		wish.WithMiddleware(
-			bm.MiddlewareWithColorProfile(teaHandler(w), termenv.TrueColor),
+			bm.MiddlewareWithProgramHandler(programHandler(w), termenv.TrueColor),
 			DisconnectHandlerMiddleware(w),
 			lm.Middleware(),
 		),
@@ -60,21 +62,57 @@ func DisconnectHandlerMiddleware(w *world.World) wish.Middleware {
 	}
 }
 
-func teaHandler(w *world.World) bm.Handler {
-	return func(s ssh.Session) (tea.Model, []tea.ProgramOption) {
+// programHandler creates the bubbletea program for a session, and subscribes it to world changes
+// so the UI redraws when (and only when) something it displays has changed.
+func programHandler(w *world.World) bm.ProgramHandler {
+	return func(s ssh.Session) *tea.Program {
 		pty, _, active := s.Pty()
 		if !active {
 			wish.Fatalln(s, "no active terminal, skipping")
-			return nil, nil
+			return nil
 		}
 		pubKey := string(gossh.MarshalAuthorizedKey(s.PublicKey()))
-		m := ui.NewUIModel(w, pubKey, s.User(), pty.Window.Width, pty.Window.Height)
+		if until, banned := w.Banned(pubKey); banned {
+			wish.Fatalf(s, "You are banned until %s\n", until.Format("2006-01-02 15:04 MST"))
+			return nil
+		}
 		w.PlayerJoin(pubKey, s.User())
-		w.OnPlayerDeath(pubKey, func() {
-			_ = s.Exit(0)
+		if lang, ok := sessionLanguage(s); ok {
+			w.SetLocale(pubKey, i18n.Parse(lang))
+		}
+		notifications := make(chan world.Notification)
+		m := ui.NewUIModel(w, pubKey, s.User(), pty.Window.Width, pty.Window.Height).Listen(notifications, s.Context().Done())
+		p := tea.NewProgram(m, tea.WithAltScreen(), tea.WithInput(s), tea.WithOutput(s))
+		w.Subscribe(pubKey, func(n world.Notification) {
+			// the program picks these up with a command rather than Program.Send, which would block
+			// forever once the program has exited. a session that's gone stops the wait.
+			select {
+			case notifications <- n:
+			case <-s.Context().Done():
+			}
+		})
+		w.OnPlayerKick(pubKey, func() {
+			_ = s.Exit(1)
 		})
-		return m, []tea.ProgramOption{tea.WithAltScreen()}
+		return p
+	}
+}
+
+// sessionLanguage finds the client's locale in the environment it sent, if any. most ssh clients
+// forward LANG and LC_* by default.
+func sessionLanguage(s ssh.Session) (string, bool) {
+	env := make(map[string]string)
+	for _, kv := range s.Environ() {
+		if k, v, ok := strings.Cut(kv, "="); ok {
+			env[k] = v
+		}
+	}
+	for _, k := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if v := env[k]; v != "" && v != "C" && v != "POSIX" {
+			return v, true
+		}
 	}
+	return "", false
 }
 
 func (s *Server) Listen() {
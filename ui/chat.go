@@ -0,0 +1,36 @@
+package ui
+
+import (
+	"github.com/charmbracelet/bubbles/viewport"
+	"github.com/muesli/reflow/wordwrap"
+)
+
+// chatFeed holds the world feed shown in the chat sidebar. it keeps the unwrapped content so it
+// can be re-wrapped when the layout changes width.
+type chatFeed struct {
+	viewport viewport.Model
+	content  string
+}
+
+func newChatFeed(width, height int) *chatFeed {
+	return &chatFeed{viewport: viewport.New(width, height)}
+}
+
+func (c *chatFeed) SetContent(s string) {
+	c.content = s
+	c.viewport.SetContent(wordwrap.String(s, c.viewport.Width))
+	c.viewport.GotoBottom()
+}
+
+func (c *chatFeed) Resize(width, height int) {
+	c.viewport.Height = height
+	if c.viewport.Width != width {
+		c.viewport.Width = width
+		c.viewport.SetContent(wordwrap.String(c.content, width))
+		c.viewport.GotoBottom()
+	}
+}
+
+func (c *chatFeed) View() string {
+	return c.viewport.View()
+}
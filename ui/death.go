@@ -0,0 +1,73 @@
+package ui
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/dustmason/nicefort/world"
+	"strings"
+)
+
+var (
+	deathTitleStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#FF5F5F")).Bold(true)
+	deathStyle      = lipgloss.NewStyle().Foreground(lipgloss.Color("#C1C6B2"))
+)
+
+// die switches to the death screen, dropping whatever the player was doing
+func (m UIModel) die() UIModel {
+	m.mode = Dead
+	m.chatInput.SetValue("")
+	m.chatInput.Blur()
+	m.dropInput.Blur()
+	m.help.ShowAll = false
+	return m
+}
+
+func (m UIModel) handleDeadModeMessage(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, m.keys.Respawn):
+			m.world.Respawn(m.playerID)
+			m.mode = Map
+			m.mapFrame = world.NewMapFrame()
+		case key.Matches(msg, m.keys.TextMode):
+			m.textMode = !m.textMode
+		case key.Matches(msg, m.keys.Quit):
+			m.quitting = true
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+// renderDeath is plain text, so it reads the same in text mode
+func (m UIModel) renderDeath() string {
+	d, _ := m.world.Death(m.playerID)
+	var b strings.Builder
+	b.WriteString(d.Cause.In(m.locale) + "\n")
+	b.WriteString(m.t("You survived %d days", d.Days) + "\n\n")
+	b.WriteString(m.t("Animals killed: %d", d.Kills) + "\n")
+	b.WriteString(m.t("Plants harvested: %d", d.Harvests) + "\n")
+	b.WriteString(m.t("Items crafted: %d", d.Crafted) + "\n")
+	b.WriteString(m.t("Steps taken: %d", d.Steps) + "\n\n")
+	if d.KeepsMap {
+		b.WriteString(m.t("You'll remember the land you explored") + "\n")
+	} else {
+		b.WriteString(m.t("You'll forget the land you explored") + "\n")
+	}
+	b.WriteString(m.t("Press r to respawn, or ctrl+c to quit"))
+	return b.String()
+}
+
+func (m UIModel) viewDeath() string {
+	if m.textMode {
+		return m.t("You died") + "\n" + m.renderDeath() + "\n"
+	}
+	msg := lipgloss.JoinVertical(
+		lipgloss.Center,
+		deathTitleStyle.Render(m.t("You died")),
+		"",
+		deathStyle.Copy().Align(lipgloss.Center).Render(m.renderDeath()),
+	)
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, msg)
+}
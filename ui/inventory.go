@@ -0,0 +1,131 @@
+package ui
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/dustmason/nicefort/i18n"
+	"github.com/dustmason/nicefort/world"
+	"strconv"
+	"strings"
+)
+
+const wieldedMarker = "⚔"
+const wornMarker = "◆"
+const itemDetailHeight = 7
+
+var (
+	itemDetailStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#C1C6B2"))
+	hintStyle       = lipgloss.NewStyle().Faint(true)
+)
+
+func newDropInput() textinput.Model {
+	ti := textinput.New()
+	ti.Prompt = "drop how many? " // replaced with a translation by localize
+	ti.CharLimit = 5
+	ti.Width = 6
+	return ti
+}
+
+// wearing reports whether the player has the item on
+func (m UIModel) wearing(itemID string) bool {
+	for _, i := range m.world.PlayerWearing(m.playerID) {
+		if i.ID == itemID {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedInventory is the player's inventory in the order shown in the table. the sort is stable, so
+// the table cursor can be used to look up the selected item.
+func (m UIModel) sortedInventory() []*world.InventoryItem {
+	return world.SortInventory(m.world.PlayerInventory(m.playerID), m.inventorySort)
+}
+
+func (m UIModel) selectedInventoryItem() (*world.InventoryItem, bool) {
+	ii := m.sortedInventory()
+	c := m.inventory.Cursor()
+	if c < 0 || c >= len(ii) {
+		return nil, false
+	}
+	return ii[c], true
+}
+
+func (m UIModel) dropSelected(quantity int) UIModel {
+	ii, ok := m.selectedInventoryItem()
+	if !ok {
+		return m
+	}
+	m.world.DropItem(m.playerID, ii.Item.ID, quantity)
+	m.inventory.SetRows(m.createInventoryTableRows())
+	m.inventory.SetCursor(m.inventory.Cursor()) // clamps the cursor if the last row was dropped
+	m.recipes.SetItems(m.createRecipeListItems())
+	return m
+}
+
+func (m UIModel) handleDropInputMessage(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, m.keys.Enter):
+			n, err := strconv.Atoi(strings.TrimSpace(m.dropInput.Value()))
+			m.dropInput.Blur()
+			if err == nil {
+				m = m.dropSelected(n)
+			}
+			return m, nil
+		case key.Matches(msg, m.keys.Esc):
+			m.dropInput.Blur()
+			return m, nil
+		}
+	}
+	m.dropInput, cmd = m.dropInput.Update(msg)
+	return m, cmd
+}
+
+func (m UIModel) inventoryHint() string {
+	if m.dropInput.Focused() {
+		return m.dropInput.View()
+	}
+	return hintStyle.Render(m.t("by %s · x/n/X drop", i18n.Name(m.inventorySort.String())))
+}
+
+// renderItemDetail describes the selected inventory item below the table
+func (m UIModel) renderItemDetail() string {
+	ii, ok := m.selectedInventoryItem()
+	if !ok {
+		return ""
+	}
+	var b strings.Builder
+	item := ii.Item
+	b.WriteString(m.t(item.Name))
+	if w := m.world.PlayerWielding(m.playerID); w != nil && w.ID == item.ID {
+		b.WriteString(" " + wieldedMarker + " " + m.t("wielding"))
+	}
+	if m.wearing(item.ID) {
+		b.WriteString(" " + wornMarker + " " + m.t("wearing"))
+	}
+	b.WriteString("\n")
+	b.WriteString(m.t("%s, %.2f each, %.1f total", i18n.Name(item.Category()), item.Weight, ii.Weight()) + "\n")
+	if traits := item.TraitNames(); len(traits) > 0 {
+		for i, t := range traits {
+			traits[i] = m.t(t)
+		}
+		b.WriteString(m.t("Traits: %s", strings.Join(traits, ", ")) + "\n")
+	}
+	if item.Description != "" {
+		b.WriteString(m.t(item.Description) + "\n")
+	}
+	if note, ok := m.world.FoodNote(m.playerID, item.ID); ok {
+		b.WriteString(note.In(m.locale) + "\n")
+	}
+	if note, ok := m.world.RemedyNote(m.playerID, item.ID); ok {
+		b.WriteString(note.In(m.locale) + "\n")
+	}
+	if item.Usable() {
+		b.WriteString(hintStyle.Render(m.t("enter to use")) + "\n")
+	}
+	return itemDetailStyle.Copy().Width(m.mainWidth()/2 - 1).MaxHeight(itemDetailHeight).Render(b.String())
+}
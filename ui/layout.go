@@ -0,0 +1,115 @@
+package ui
+
+import (
+	"github.com/charmbracelet/lipgloss"
+	"github.com/dustmason/nicefort/i18n"
+	"strings"
+)
+
+// layoutSize is a breakpoint chosen from the terminal dimensions
+type layoutSize int
+
+const (
+	tooSmall layoutSize = iota // below the minimum playable size
+	compact                    // sidebars collapse into tabs that replace the main pane
+	regular                    // both sidebars visible
+	wide                       // both sidebars visible, with a wider chat
+)
+
+const (
+	minWidth       = 60
+	minHeight      = 20
+	regularWidth   = 100 // at or above this width both sidebars are shown
+	wideWidth      = 160 // at or above this width chat gets more room
+	sidebarWidth   = 20
+	wideChatWidth  = 40
+	borderWidth    = 2
+	eventFeedLines = 4
+)
+
+// Pane is what occupies the main area in the compact layout
+type Pane int
+
+const (
+	MapPane Pane = iota
+	StatusPane
+	ChatPane
+)
+
+var paneNames = []string{"Map", "Status", "Chat"}
+
+type layout struct {
+	size       layoutSize
+	infoWidth  int // width of the player info sidebar, 0 when collapsed
+	chatWidth  int // width of the chat sidebar, 0 when collapsed
+	mainWidth  int
+	mainHeight int
+}
+
+func newLayout(width, height int) layout {
+	l := layout{}
+	switch {
+	case width < minWidth || height < minHeight:
+		l.size = tooSmall
+	case width < regularWidth:
+		l.size = compact
+	case width < wideWidth:
+		l.size = regular
+		l.infoWidth = sidebarWidth
+		l.chatWidth = sidebarWidth
+	default:
+		l.size = wide
+		l.infoWidth = sidebarWidth
+		l.chatWidth = wideChatWidth
+	}
+	l.mainWidth = width - borderWidth - l.infoWidth
+	if l.chatWidth > 0 { // the chat input box has a border
+		l.mainWidth -= l.chatWidth + borderWidth
+	}
+	l.mainHeight = height - eventFeedLines - 4 // -4 for borders and statusbar
+	if l.size == compact {
+		l.mainHeight -= 1 // tab bar
+	}
+	if l.mainWidth < 0 {
+		l.mainWidth = 0
+	}
+	if l.mainHeight < 0 {
+		l.mainHeight = 0
+	}
+	return l
+}
+
+// chatViewportWidth is the width available to chat text, whether in the sidebar or the chat pane
+func (l layout) chatViewportWidth() int {
+	if l.chatWidth > 0 {
+		return l.chatWidth
+	}
+	return l.mainWidth
+}
+
+var (
+	tabActiveStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("229")).Background(lipgloss.Color("57")).Padding(0, 1)
+	tabInactiveStyle = lipgloss.NewStyle().Faint(true).Padding(0, 1)
+	tooSmallStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("#FDC300")).Bold(true)
+)
+
+func renderTabs(active Pane, l i18n.Locale) string {
+	parts := make([]string, len(paneNames))
+	for i, name := range paneNames {
+		if Pane(i) == active {
+			parts[i] = tabActiveStyle.Render(l.T(name))
+		} else {
+			parts[i] = tabInactiveStyle.Render(l.T(name))
+		}
+	}
+	return strings.Join(parts, "") + tabInactiveStyle.Render(l.T("(tab to switch)"))
+}
+
+func renderTooSmall(width, height int, l i18n.Locale) string {
+	msg := lipgloss.JoinVertical(
+		lipgloss.Center,
+		tooSmallStyle.Render(l.T("terminal too small")),
+		l.T("%dx%d, need at least %dx%d", width, height, minWidth, minHeight),
+	)
+	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, msg)
+}
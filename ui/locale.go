@@ -0,0 +1,38 @@
+package ui
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/dustmason/nicefort/i18n"
+	"reflect"
+)
+
+// localized returns a copy of the key map with help text in the given language
+func (k keyMap) localized(l i18n.Locale) keyMap {
+	v := reflect.ValueOf(&k).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		b := v.Field(i).Addr().Interface().(*key.Binding)
+		h := b.Help()
+		b.SetHelp(h.Key, l.T(h.Desc))
+	}
+	return k
+}
+
+// localize picks up the player's language and re-labels everything the model holds on to. text that
+// is rendered on the fly uses m.t instead.
+func (m UIModel) localize() UIModel {
+	m.locale = m.world.PlayerLocale(m.playerID)
+	m.keys = keys.localized(m.locale)
+	m.chatInput.Placeholder = m.t("chat")
+	m.log.search.Placeholder = m.t("search")
+	m.dropInput.Prompt = m.t("drop how many?") + " "
+	if m.mode == Inventory {
+		m.inventory = m.createInventoryTable()
+		m.recipes = m.createRecipeList()
+	}
+	return m
+}
+
+// t translates player-facing text into the player's language
+func (m UIModel) t(format string, args ...interface{}) string {
+	return m.locale.T(format, args...)
+}
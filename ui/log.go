@@ -0,0 +1,127 @@
+package ui
+
+import (
+	"fmt"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/dustmason/nicefort/events"
+	"github.com/dustmason/nicefort/i18n"
+	"github.com/muesli/reflow/wordwrap"
+	"strings"
+)
+
+// logModel is the full-screen message log. it shows the player's own history merged with the
+// world feed, and can be filtered by topic and searched by text.
+type logModel struct {
+	viewport viewport.Model
+	search   textinput.Model
+	topics   map[events.Topic]bool
+}
+
+func newLogModel() logModel {
+	ti := textinput.New()
+	ti.Placeholder = "search"
+	ti.Prompt = "/"
+	ti.CharLimit = 60
+	topics := make(map[events.Topic]bool)
+	for _, t := range events.Topics {
+		topics[t] = true
+	}
+	return logModel{
+		viewport: viewport.New(0, 0),
+		search:   ti,
+		topics:   topics,
+	}
+}
+
+func (l logModel) filter() events.Filter {
+	return events.Filter{Topics: l.topics, Query: l.search.Value()}
+}
+
+var (
+	logTopicOnStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("229")).Background(lipgloss.Color("57"))
+	logTopicOffStyle = lipgloss.NewStyle().Faint(true)
+)
+
+// header renders the topic toggles and the search box
+func (l logModel) header(loc i18n.Locale) string {
+	parts := make([]string, len(events.Topics))
+	for i, t := range events.Topics {
+		label := fmt.Sprintf(" %d %s ", i+1, loc.T(t.String()))
+		if l.topics[t] {
+			parts[i] = logTopicOnStyle.Render(label)
+		} else {
+			parts[i] = logTopicOffStyle.Render(label)
+		}
+	}
+	return strings.Join(parts, " ") + "  " + l.search.View()
+}
+
+func (m UIModel) openLog() UIModel {
+	m.mode = Log
+	m.log.viewport.Width = m.mainWidth()
+	m.log.viewport.Height = m.mainHeight() - 2 // -2 for the header
+	m = m.refreshLog()
+	m.log.viewport.GotoBottom()
+	return m
+}
+
+func (m UIModel) refreshLog() UIModel {
+	content := m.world.RenderEventLog(m.playerID, m.log.filter())
+	m.log.viewport.SetContent(wordwrap.String(content, m.log.viewport.Width))
+	return m
+}
+
+// followLog picks up new messages without disturbing the scroll position, unless already at the bottom
+func (m UIModel) followLog() UIModel {
+	atBottom := m.log.viewport.AtBottom()
+	m = m.refreshLog()
+	if atBottom {
+		m.log.viewport.GotoBottom()
+	}
+	return m
+}
+
+func (m UIModel) handleLogModeMessage(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	if m.log.search.Focused() {
+		if msg, ok := msg.(tea.KeyMsg); ok {
+			switch {
+			case key.Matches(msg, m.keys.Enter):
+				m.log.search.Blur()
+				return m.refreshLog(), nil
+			case key.Matches(msg, m.keys.Esc):
+				m.log.search.SetValue("")
+				m.log.search.Blur()
+				return m.refreshLog(), nil
+			}
+		}
+		m.log.search, cmd = m.log.search.Update(msg)
+		return m.refreshLog(), cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.keys.Esc), key.Matches(msg, m.keys.FocusLog):
+			m.mode = Map
+			return m, nil
+		case key.Matches(msg, m.keys.Search):
+			return m, m.log.search.Focus()
+		case key.Matches(msg, m.keys.ToggleTopic):
+			i := int(msg.Runes[0] - '1')
+			t := events.Topics[i]
+			m.log.topics[t] = !m.log.topics[t]
+		}
+	}
+	m = m.refreshLog()
+	m.log.viewport, cmd = m.log.viewport.Update(msg)
+	return m, cmd
+}
+
+func (m UIModel) renderLog() string {
+	return lipgloss.JoinVertical(lipgloss.Left, m.log.header(m.locale), "", m.log.viewport.View())
+}
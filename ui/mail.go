@@ -0,0 +1,91 @@
+package ui
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/dustmason/nicefort/events"
+	"github.com/dustmason/nicefort/world"
+	"github.com/muesli/reflow/wordwrap"
+	"strings"
+)
+
+// mailModel is the mailbox screen, listing letters left with /mail above the selected one
+type mailModel struct {
+	letters []world.Letter // newest first
+	cursor  int
+}
+
+func (m UIModel) openMail() UIModel {
+	m.mode = Mail
+	m.mail.cursor = 0
+	return m.refreshMail()
+}
+
+// refreshMail reloads the letters, keeps the cursor in range and marks the selected letter read
+func (m UIModel) refreshMail() UIModel {
+	m.mail.letters = m.world.Mail(m.playerID)
+	if m.mail.cursor >= len(m.mail.letters) {
+		m.mail.cursor = len(m.mail.letters) - 1
+	}
+	if m.mail.cursor < 0 {
+		m.mail.cursor = 0
+	}
+	if l, ok := m.selectedLetter(); ok && !l.Read {
+		m.world.ReadMail(m.playerID, l.ID)
+		m.mail.letters[m.mail.cursor].Read = true
+	}
+	return m
+}
+
+func (m UIModel) selectedLetter() (world.Letter, bool) {
+	if m.mail.cursor >= len(m.mail.letters) {
+		return world.Letter{}, false
+	}
+	return m.mail.letters[m.mail.cursor], true
+}
+
+func (m UIModel) handleMailModeMessage(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, m.keys.Esc), key.Matches(msg, m.keys.FocusMail):
+			m.mode = Map
+		case key.Matches(msg, m.keys.Up):
+			m.mail.cursor--
+			m = m.refreshMail()
+		case key.Matches(msg, m.keys.Down):
+			m.mail.cursor++
+			m = m.refreshMail()
+		case key.Matches(msg, m.keys.Delete):
+			if l, ok := m.selectedLetter(); ok {
+				m.world.DeleteMail(m.playerID, l.ID)
+				m = m.refreshMail()
+			}
+		}
+	}
+	return m, nil
+}
+
+// renderMail is plain text, so it reads the same in text mode
+func (m UIModel) renderMail() string {
+	var b strings.Builder
+	b.WriteString(m.t("Mailbox, %d letters. D to delete, esc to close.", len(m.mail.letters)) + "\n\n")
+	if len(m.mail.letters) == 0 {
+		b.WriteString(m.t("No letters.") + "\n")
+		return b.String()
+	}
+	for i, l := range m.mail.letters {
+		marker := "  "
+		if i == m.mail.cursor {
+			marker = "> "
+		}
+		status := ""
+		if !l.Read {
+			status = " " + m.t("(unread)")
+		}
+		b.WriteString(marker + m.t("From %s, %s ago", l.From, events.RelativeTime(l.Sent)) + status + "\n")
+	}
+	if l, ok := m.selectedLetter(); ok {
+		b.WriteString("\n" + wordwrap.String(l.Text, m.mainWidth()-2) + "\n")
+	}
+	return b.String()
+}
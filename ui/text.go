@@ -0,0 +1,78 @@
+package ui
+
+import (
+	"github.com/dustmason/nicefort/i18n"
+	"github.com/muesli/reflow/wordwrap"
+	"strings"
+)
+
+// textModeEvents is how many recent events are announced in text mode
+const textModeEvents = 5
+
+// renderTextMode is the accessible alternative to the map grid. everything is plain, linear text that
+// a screen reader can follow: status first, then whatever the current mode shows, then recent events.
+func (m UIModel) renderTextMode() string {
+	var b strings.Builder
+	b.WriteString(m.t("Text mode. Press v to show the map, ? for help.") + "\n")
+	b.WriteString(m.world.RenderWorldStatus(m.playerID) + ". " + m.world.RenderPosition(m.playerID) + ".\n")
+	b.WriteString(m.world.DescribePlayer(m.playerID) + "\n\n")
+	switch m.mode {
+	case Map:
+		for _, line := range m.world.DescribeSurroundings(m.playerID) {
+			b.WriteString(line + "\n")
+		}
+		b.WriteString("\n" + m.t("Recent events:") + "\n")
+		for _, line := range m.world.RecentEvents(m.playerID, textModeEvents) {
+			b.WriteString(line + "\n")
+		}
+		if m.chatInput.Focused() {
+			b.WriteString("\n" + m.chatInput.View() + "\n")
+		}
+	case Inventory:
+		m.writeTextInventory(&b)
+	case Log:
+		b.WriteString(m.log.header(m.locale) + "\n\n" + m.log.viewport.View() + "\n")
+	case Mail:
+		b.WriteString(m.renderMail())
+	}
+	if m.help.ShowAll {
+		b.WriteString("\n" + m.help.View(m.keys) + "\n")
+	}
+	return wordwrap.String(b.String(), m.width)
+}
+
+// writeTextInventory lists the inventory and recipes one per line, marking the selected row with ">"
+func (m UIModel) writeTextInventory(b *strings.Builder) {
+	marker := func(selected bool) string {
+		if selected {
+			return "> "
+		}
+		return "  "
+	}
+	ii := m.sortedInventory()
+	b.WriteString(m.t("Inventory, %d items, sorted by %s:", len(ii), i18n.Name(m.inventorySort.String())) + "\n")
+	wielding := m.world.PlayerWielding(m.playerID)
+	for i, item := range ii {
+		line := m.t("%s x %d, weight %.1f", i18n.Name(item.Item.Name), item.Quantity, item.Weight())
+		if wielding.ID == item.Item.ID {
+			line += ", " + m.t("wielded")
+		}
+		if m.wearing(item.Item.ID) {
+			line += ", " + m.t("worn")
+		}
+		b.WriteString(marker(m.inventoryMode == InventoryList && i == m.inventory.Cursor()) + line + "\n")
+	}
+	b.WriteString("\n" + m.t("Recipes:") + "\n")
+	for i, li := range m.recipes.Items() {
+		r, ok := li.(recipeListItem)
+		if !ok {
+			continue
+		}
+		status := m.t("can craft")
+		if !r.craftable {
+			status = r.description
+		}
+		selected := m.inventoryMode == RecipeList && i == m.recipes.Index()
+		b.WriteString(marker(selected) + r.name + ": " + status + "\n")
+	}
+}
@@ -7,15 +7,13 @@ import (
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/table"
 	"github.com/charmbracelet/bubbles/textinput"
-	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
-	"github.com/dustmason/nicefort/events"
+	"github.com/dustmason/nicefort/i18n"
+	"github.com/dustmason/nicefort/util"
 	"github.com/dustmason/nicefort/world"
-	"github.com/muesli/reflow/wordwrap"
 	"strconv"
 	"strings"
-	"time"
 )
 
 type Mode int
@@ -24,6 +22,9 @@ type InventoryMode int
 const (
 	Map Mode = iota
 	Inventory
+	Log
+	Mail
+	Dead
 )
 
 const (
@@ -34,6 +35,8 @@ const (
 type UIModel struct {
 	mode          Mode
 	world         *world.World
+	notifications <-chan world.Notification
+	closed        <-chan struct{} // closed when the session ends, so nothing waits on notifications
 	playerID      string
 	playerName    string
 	width         int
@@ -41,26 +44,30 @@ type UIModel struct {
 	quitting      bool
 	keys          keyMap
 	help          help.Model
-	chat          *viewport.Model
+	chat          *chatFeed
 	chatInput     textinput.Model
 	inventory     table.Model
 	recipes       list.Model
 	inventoryMode InventoryMode
+	inventorySort world.InventorySort
+	dropInput     textinput.Model
+	log           logModel
+	mail          mailModel
+	layout        layout
+	mapFrame      *world.MapFrame
+	pane          Pane // what the main area shows in the compact layout
+	textMode      bool // describe surroundings in plain text instead of drawing the map
+	locale        i18n.Locale
 }
 
 func NewUIModel(w *world.World, playerID, playerName string, width, height int) UIModel {
 	ti := textinput.New()
 	ti.Placeholder = "chat"
 	ti.CharLimit = 240
-	ti.Width = 17
 
-	chat := viewport.New(20, height-5) // -5 for chatInput
-	w.OnEvent(playerID, func(events string) {
-		chat.SetContent(wordwrap.String(events, 20))
-		chat.GotoBottom()
-	})
+	chat := newChatFeed(0, 0) // sized by applyLayout
 
-	return UIModel{
+	m := UIModel{
 		mode:          Map,
 		world:         w,
 		playerID:      playerID,
@@ -69,11 +76,18 @@ func NewUIModel(w *world.World, playerID, playerName string, width, height int)
 		height:        height,
 		keys:          keys,
 		help:          help.New(),
-		chat:          &chat,
+		chat:          chat,
 		chatInput:     ti,
 		inventory:     table.New(),
 		inventoryMode: InventoryList,
+		dropInput:     newDropInput(),
+		mapFrame:      world.NewMapFrame(),
+		log:           newLogModel(),
 	}
+	if _, dead := w.Death(playerID); dead {
+		m = m.die()
+	}
+	return m.localize().applyLayout()
 }
 
 type keyMap struct {
@@ -88,6 +102,21 @@ type keyMap struct {
 	Help           key.Binding
 	FocusChat      key.Binding
 	FocusInventory key.Binding
+	FocusLog       key.Binding
+	FocusMail      key.Binding
+	Rest           key.Binding
+	Sleep          key.Binding
+	Delete         key.Binding
+	Respawn        key.Binding
+	Search         key.Binding
+	ToggleTopic    key.Binding
+	Timestamps     key.Binding
+	TextMode       key.Binding
+	Language       key.Binding
+	DropOne        key.Binding
+	DropN          key.Binding
+	DropAll        key.Binding
+	Sort           key.Binding
 	Enter          key.Binding
 	Space          key.Binding
 	Tab            key.Binding
@@ -101,8 +130,8 @@ func (k keyMap) ShortHelp() []key.Binding {
 
 func (k keyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
-		{k.Up, k.Down, k.Left, k.Right}, // first column
-		{k.Help, k.Quit},                // second column
+		{k.Up, k.Down, k.Left, k.Right, k.Rest, k.Sleep},                                // first column
+		{k.FocusLog, k.FocusMail, k.Timestamps, k.TextMode, k.Language, k.Help, k.Quit}, // second column
 	}
 }
 
@@ -151,6 +180,66 @@ var keys = keyMap{
 		key.WithKeys("i"),
 		key.WithHelp("i", "show inventory browser"),
 	),
+	FocusLog: key.NewBinding(
+		key.WithKeys("m"),
+		key.WithHelp("m", "show message log"),
+	),
+	FocusMail: key.NewBinding(
+		key.WithKeys("M"),
+		key.WithHelp("M", "read mail"),
+	),
+	Rest: key.NewBinding(
+		key.WithKeys("s"),
+		key.WithHelp("s", "rest"),
+	),
+	Sleep: key.NewBinding(
+		key.WithKeys("S"),
+		key.WithHelp("S", "sleep"),
+	),
+	Delete: key.NewBinding(
+		key.WithKeys("D", "delete"),
+		key.WithHelp("D", "delete letter"),
+	),
+	Respawn: key.NewBinding(
+		key.WithKeys("r"),
+		key.WithHelp("r", "respawn"),
+	),
+	Search: key.NewBinding(
+		key.WithKeys("/"),
+		key.WithHelp("/", "search"),
+	),
+	ToggleTopic: key.NewBinding(
+		key.WithKeys("1", "2", "3", "4", "5"),
+		key.WithHelp("1-5", "toggle topic"),
+	),
+	Timestamps: key.NewBinding(
+		key.WithKeys("T"),
+		key.WithHelp("T", "cycle event timestamps"),
+	),
+	TextMode: key.NewBinding(
+		key.WithKeys("v"),
+		key.WithHelp("v", "toggle text mode"),
+	),
+	Language: key.NewBinding(
+		key.WithKeys("L"),
+		key.WithHelp("L", "change language"),
+	),
+	DropOne: key.NewBinding(
+		key.WithKeys("x"),
+		key.WithHelp("x", "drop one"),
+	),
+	DropN: key.NewBinding(
+		key.WithKeys("n"),
+		key.WithHelp("n", "drop some"),
+	),
+	DropAll: key.NewBinding(
+		key.WithKeys("X"),
+		key.WithHelp("X", "drop all"),
+	),
+	Sort: key.NewBinding(
+		key.WithKeys("s"),
+		key.WithHelp("s", "change sort"),
+	),
 	Enter: key.NewBinding(
 		key.WithKeys("enter"),
 	),
@@ -169,26 +258,62 @@ var keys = keyMap{
 	),
 }
 
-type TickMsg time.Time
+// Listen has the model pick up world notifications from n until closed is closed
+func (m UIModel) Listen(n <-chan world.Notification, closed <-chan struct{}) UIModel {
+	m.notifications = n
+	m.closed = closed
+	return m
+}
 
-func doTick() tea.Cmd {
-	return tea.Tick(time.Millisecond*100, func(t time.Time) tea.Msg {
-		return TickMsg(t)
-	})
+// waitForNotification is a command that waits for the next world notification. bubbletea stops
+// waiting on a command's result once the program exits, so unlike Program.Send this can't block a
+// sender forever.
+func (m UIModel) waitForNotification() tea.Cmd {
+	if m.notifications == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		select {
+		case n := <-m.notifications:
+			return n
+		case <-m.closed:
+			return nil
+		}
+	}
 }
 
 func (m UIModel) Init() tea.Cmd {
-	return doTick()
+	return m.waitForNotification()
 }
 
 func (m UIModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
-	case TickMsg:
-		return m, doTick()
+	case world.Notification:
+		// the world sends these whenever something this session displays has changed. returning
+		// is enough to trigger a redraw.
+		if msg.Changes&world.FeedChanged != 0 {
+			m.chat.SetContent(msg.Feed)
+		}
+		if m.world.PlayerLocale(m.playerID) != m.locale {
+			m = m.localize()
+		}
+		if m.mode == Log && msg.Changes&(world.FeedChanged|world.EventsChanged) != 0 {
+			m = m.followLog()
+		}
+		if m.mode == Mail && msg.Changes&world.StatsChanged != 0 {
+			m = m.refreshMail()
+		}
+		if msg.Changes&world.Died != 0 {
+			m = m.die()
+		}
+		return m, m.waitForNotification()
 	case tea.WindowSizeMsg:
 		m.height = msg.Height
 		m.width = msg.Width
-		m.chat.Height = msg.Height - 5
+		m = m.applyLayout()
+	}
+	if m.mode == Dead {
+		return m.handleDeadModeMessage(msg)
 	}
 	if m.chatInput.Focused() {
 		return m.handleChatModeMessage(msg)
@@ -196,6 +321,12 @@ func (m UIModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	if m.mode == Inventory {
 		return m.handleInventoryModeMessage(msg)
 	}
+	if m.mode == Log {
+		return m.handleLogModeMessage(msg)
+	}
+	if m.mode == Mail {
+		return m.handleMailModeMessage(msg)
+	}
 	return m.handleMapModeMessage(msg)
 }
 
@@ -206,7 +337,7 @@ func (m UIModel) handleChatModeMessage(msg tea.Msg) (tea.Model, tea.Cmd) {
 		switch {
 		case key.Matches(msg, m.keys.Enter):
 			if m.chatInput.Focused() {
-				m.world.Chat(events.Info, m.playerName, m.chatInput.Value())
+				m.world.SendChat(m.playerID, m.chatInput.Value())
 				m.chatInput.SetValue("")
 				m.chatInput.Blur()
 			}
@@ -243,14 +374,36 @@ func (m UIModel) handleMapModeMessage(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.help.ShowAll = !m.help.ShowAll
 		case key.Matches(msg, m.keys.Space):
 			m.world.InteractPlayer(m.playerID)
+		case key.Matches(msg, m.keys.Rest):
+			m.world.RestPlayer(m.playerID)
+		case key.Matches(msg, m.keys.Sleep):
+			m.world.SleepPlayer(m.playerID)
 		case key.Matches(msg, m.keys.FocusChat):
 			if !m.chatInput.Focused() {
 				m.chatInput.Focus()
+				if m.layout.size == compact {
+					m.pane = ChatPane
+				}
+			}
+		case key.Matches(msg, m.keys.Tab):
+			if m.layout.size == compact {
+				m.pane = (m.pane + 1) % Pane(len(paneNames))
 			}
 		case key.Matches(msg, m.keys.FocusInventory):
 			m.inventory = m.createInventoryTable()
 			m.recipes = m.createRecipeList()
 			m.mode = Inventory
+		case key.Matches(msg, m.keys.FocusLog):
+			m = m.openLog()
+		case key.Matches(msg, m.keys.FocusMail):
+			m = m.openMail()
+		case key.Matches(msg, m.keys.Timestamps):
+			m.world.CycleTimestamps(m.playerID)
+		case key.Matches(msg, m.keys.TextMode):
+			m.textMode = !m.textMode
+		case key.Matches(msg, m.keys.Language):
+			m.world.CycleLocale(m.playerID)
+			m = m.localize()
 		case key.Matches(msg, m.keys.Quit):
 			m.quitting = true
 			return m, tea.Quit
@@ -261,6 +414,9 @@ func (m UIModel) handleMapModeMessage(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 func (m UIModel) handleInventoryModeMessage(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
+	if m.dropInput.Focused() {
+		return m.handleDropInputMessage(msg)
+	}
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		switch {
@@ -280,9 +436,10 @@ func (m UIModel) handleInventoryModeMessage(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		case key.Matches(msg, m.keys.Enter):
 			if m.inventoryMode == InventoryList {
-				i, _ := strconv.Atoi(m.inventory.SelectedRow()[0])
-				m.world.ActivateItem(m.playerID, i)
-				m.mode = Map
+				if ii, ok := m.selectedInventoryItem(); ok {
+					m.world.ActivateItem(m.playerID, ii.Item.ID)
+					m.mode = Map
+				}
 			} else {
 				if m.recipes.FilterState() == list.Filtering {
 					break
@@ -294,12 +451,30 @@ func (m UIModel) handleInventoryModeMessage(msg tea.Msg) (tea.Model, tea.Cmd) {
 						}
 						cmd = m.recipes.SetItems(m.createRecipeListItems())
 						var cmd2 tea.Cmd
-						m.recipes, cmd2 = m.recipes.Update(TickMsg{})
+						m.recipes, cmd2 = m.recipes.Update(nil)
 						return m, tea.Batch(cmd, cmd2)
 					}
 				}
 			}
 		}
+		if m.inventoryMode == InventoryList {
+			switch {
+			case key.Matches(msg, m.keys.DropOne):
+				return m.dropSelected(1), nil
+			case key.Matches(msg, m.keys.DropAll):
+				if ii, ok := m.selectedInventoryItem(); ok {
+					return m.dropSelected(ii.Quantity), nil
+				}
+				return m, nil
+			case key.Matches(msg, m.keys.DropN):
+				m.dropInput.SetValue("")
+				return m, m.dropInput.Focus()
+			case key.Matches(msg, m.keys.Sort):
+				m.inventorySort = m.inventorySort.Next()
+				m.inventory.SetRows(m.createInventoryTableRows())
+				return m, nil
+			}
+		}
 	}
 	if m.inventory.Focused() {
 		m.inventory, cmd = m.inventory.Update(msg)
@@ -312,16 +487,17 @@ func (m UIModel) handleInventoryModeMessage(msg tea.Msg) (tea.Model, tea.Cmd) {
 func (m UIModel) createInventoryTable() table.Model {
 	totalWidth := m.mainWidth()/2 - 5
 	columns := []table.Column{
-		{Title: "#", Width: totalWidth / 8},
-		{Title: "Item", Width: totalWidth/2 - 1},
-		{Title: "Qty", Width: totalWidth / 8},
-		{Title: "Weight", Width: totalWidth / 4},
+		{Title: "", Width: 2},
+		{Title: m.t("Item"), Width: totalWidth/2 - 1},
+		{Title: m.t("Qty"), Width: totalWidth / 8},
+		{Title: m.t("Weight"), Width: totalWidth / 4},
 	}
 
 	t := table.New(
 		table.WithColumns(columns),
 		table.WithRows(m.createInventoryTableRows()),
 		table.WithFocused(true),
+		table.WithHeight(m.mainHeight()-itemDetailHeight-4), // -4 for the title and table header
 	)
 	s := table.DefaultStyles()
 	s.Header = s.Header.
@@ -338,12 +514,19 @@ func (m UIModel) createInventoryTable() table.Model {
 }
 
 func (m UIModel) createInventoryTableRows() []table.Row {
-	ii := m.world.PlayerInventory(m.playerID)
+	ii := m.sortedInventory()
+	wielding := m.world.PlayerWielding(m.playerID)
 	rows := make([]table.Row, len(ii))
 	for ind, i := range ii {
+		marker := ""
+		if wielding != nil && wielding.ID == i.Item.ID {
+			marker = wieldedMarker
+		} else if m.wearing(i.Item.ID) {
+			marker = wornMarker
+		}
 		rows[ind] = table.Row{
-			strconv.Itoa(ind),
-			i.Item.Name,
+			marker,
+			m.t(i.Item.Name),
 			strconv.Itoa(i.Quantity),
 			fmt.Sprintf("%.1f", i.Weight()),
 		}
@@ -352,32 +535,51 @@ func (m UIModel) createInventoryTableRows() []table.Row {
 }
 
 type recipeListItem struct {
+	name        string
 	title       string
 	description string
 	id          int
+	craftable   bool
 }
 
 func (i recipeListItem) Title() string       { return i.title }
 func (i recipeListItem) Description() string { return i.description }
-func (i recipeListItem) FilterValue() string { return i.title }
+func (i recipeListItem) FilterValue() string { return i.name }
 
 func (m UIModel) createRecipeList() list.Model {
 	totalWidth := m.mainWidth()/2 - 1
 	d := list.NewDefaultDelegate()
 	lm := list.New(m.createRecipeListItems(), d, totalWidth, 20)
-	lm.Title = "Crafting Recipes"
+	lm.Title = m.t("Crafting Recipes")
 	lm.SetShowHelp(false)
-	lm.SetStatusBarItemName("recipe", "recipes")
+	lm.SetStatusBarItemName(m.t("recipe"), m.t("recipes"))
 	lm.DisableQuitKeybindings()
 	return lm
 }
 
+// createRecipeListItems lists every recipe, craftable ones first. locked recipes describe what's missing.
 func (m UIModel) createRecipeListItems() []list.Item {
-	items := make([]list.Item, 0)
-	for _, r := range m.world.AvailableRecipes(m.playerID) {
-		items = append(items, recipeListItem{title: r.Result.Name, description: r.Description, id: r.ID})
+	craftable := make([]list.Item, 0)
+	locked := make([]list.Item, 0)
+	for _, rs := range m.world.RecipeStatuses(m.playerID) {
+		if rs.Craftable {
+			name := m.t(rs.Result.Name)
+			craftable = append(craftable, recipeListItem{name: name, title: "✓ " + name, description: rs.Description.In(m.locale), id: rs.ID, craftable: true})
+			continue
+		}
+		missing := make([]string, 0)
+		for _, req := range rs.Missing() {
+			missing = append(missing, req.Text(m.locale))
+		}
+		name := m.t(rs.Result.Name)
+		locked = append(locked, recipeListItem{
+			name:        name,
+			title:       "✗ " + name,
+			description: m.t("needs %s", strings.Join(missing, ", ")),
+			id:          rs.ID,
+		})
 	}
-	return items
+	return append(craftable, locked...)
 }
 
 var (
@@ -390,9 +592,9 @@ var (
 				BorderRight(true).
 				BorderBottom(true)
 
-	playerInfoStyle   = lipgloss.NewStyle().Width(20)
-	playerEventsStyle = lipgloss.NewStyle().Height(4)
-	chatInputStyle    = lipgloss.NewStyle().Inherit(borderedBoxStyle).Height(1).Width(20)
+	playerInfoStyle   = lipgloss.NewStyle()
+	playerEventsStyle = lipgloss.NewStyle().Height(eventFeedLines)
+	chatInputStyle    = lipgloss.NewStyle().Inherit(borderedBoxStyle).Height(1)
 	statusBarStyle    = lipgloss.NewStyle().
 				Foreground(lipgloss.AdaptiveColor{Light: "#343433", Dark: "#C1C6B2"}).
 				Background(lipgloss.AdaptiveColor{Light: "#D9DCCF", Dark: "#353533"})
@@ -400,15 +602,25 @@ var (
 )
 
 func (m UIModel) View() string {
+	if m.mode == Dead {
+		return m.viewDeath()
+	}
+	if m.textMode {
+		return m.renderTextMode()
+	}
+	if m.layout.size == tooSmall {
+		return renderTooSmall(m.width, m.height, m.locale)
+	}
 	mainWidth := m.mainWidth()
-	mainHeight := m.height - 4 - 4
+	mainHeight := m.mainHeight()
 
 	// local copy, because Width/Height mutate it. this avoids `concurrent map write` panics
-	piStyle := lipgloss.NewStyle().Inherit(playerInfoStyle).Height(m.height - 1) // -1 for statusbar
-	peStyle := lipgloss.NewStyle().Inherit(playerEventsStyle).Width(mainWidth).MaxHeight(4)
+	piStyle := lipgloss.NewStyle().Inherit(playerInfoStyle).Width(m.layout.infoWidth).Height(m.height - 1) // -1 for statusbar
+	peStyle := lipgloss.NewStyle().Inherit(playerEventsStyle).Width(mainWidth).MaxHeight(eventFeedLines)
 	mainStyle := lipgloss.NewStyle().Inherit(borderedBoxStyle).Width(mainWidth).Height(mainHeight)
+	ciStyle := lipgloss.NewStyle().Inherit(chatInputStyle).Width(m.layout.chatViewportWidth())
 	sbStyleLeft := lipgloss.NewStyle().Inherit(statusBarStyle).Width(m.width / 2)
-	sbStyleRight := lipgloss.NewStyle().Inherit(statusBarStyle).Width(m.width / 2).Align(lipgloss.Right)
+	sbStyleRight := lipgloss.NewStyle().Inherit(statusBarStyle).Width(m.width - m.width/2).Align(lipgloss.Right)
 	inventoryPaneStyle := lipgloss.NewStyle().Width(mainWidth / 2).Height(mainHeight)
 	recipePaneStyle := lipgloss.NewStyle().Width(mainWidth / 2).Height(mainHeight)
 	if m.inventoryMode == InventoryList {
@@ -419,37 +631,55 @@ func (m UIModel) View() string {
 
 	var mainContents string
 	if m.mode == Map {
-		mainContents = mainStyle.Render(m.world.RenderMap(m.playerID, m.playerName, mainWidth, mainHeight))
+		switch {
+		case m.layout.size == compact && m.pane == StatusPane:
+			mainContents = mainStyle.Render(m.world.RenderPlayerSidebar(m.playerID, m.playerName))
+		case m.layout.size == compact && m.pane == ChatPane:
+			mainContents = lipgloss.JoinVertical(
+				lipgloss.Left,
+				m.chat.View(),
+				ciStyle.Render(m.chatInput.View()),
+			)
+		default:
+			mainContents = mainStyle.Render(m.world.RenderMap(m.playerID, m.playerName, mainWidth, mainHeight, m.mapFrame))
+		}
 	} else if m.mode == Inventory {
 		mainContents = lipgloss.JoinHorizontal(
 			lipgloss.Top,
 			inventoryPaneStyle.Render(
-				m.recipes.Styles.Title.Render("Inventory")+"\n\n"+m.inventory.View(),
+				m.recipes.Styles.Title.Render(m.t("Inventory"))+" "+m.inventoryHint()+"\n\n"+m.inventory.View()+"\n"+m.renderItemDetail(),
 			),
 			recipePaneStyle.Render(m.recipes.View()),
 		)
+	} else if m.mode == Log {
+		mainContents = mainStyle.Render(m.renderLog())
+	} else if m.mode == Mail {
+		mainContents = mainStyle.Render(m.renderMail())
+	}
+
+	center := []string{peStyle.Render(m.world.RenderPlayerEvents(m.playerID)), mainContents}
+	if m.layout.size == compact {
+		center = append([]string{renderTabs(m.pane, m.locale)}, center...)
+	}
+	columns := []string{lipgloss.JoinVertical(lipgloss.Left, center...)}
+	if m.layout.infoWidth > 0 {
+		columns = append([]string{piStyle.Render(m.world.RenderPlayerSidebar(m.playerID, m.playerName))}, columns...)
+	}
+	if m.layout.chatWidth > 0 {
+		columns = append(columns, lipgloss.JoinVertical(
+			lipgloss.Left,
+			m.chat.View(),
+			ciStyle.Render(m.chatInput.View()),
+		))
 	}
 
 	doc := strings.Builder{}
 	ui := lipgloss.JoinVertical(
 		lipgloss.Left,
+		lipgloss.JoinHorizontal(lipgloss.Top, columns...),
 		lipgloss.JoinHorizontal(
 			lipgloss.Top,
-			piStyle.Render(m.world.RenderPlayerSidebar(m.playerID, m.playerName)),
-			lipgloss.JoinVertical(
-				lipgloss.Left,
-				peStyle.Render(m.world.RenderPlayerEvents(m.playerID)),
-				mainContents,
-			),
-			lipgloss.JoinVertical(
-				lipgloss.Left,
-				m.chat.View(),
-				chatInputStyle.Render(m.chatInput.View()),
-			),
-		),
-		lipgloss.JoinHorizontal(
-			lipgloss.Top,
-			sbStyleLeft.Render(m.world.RenderWorldStatus()), // todo use status bar for short help text
+			sbStyleLeft.Render(m.world.RenderWorldStatus(m.playerID)), // todo use status bar for short help text
 			sbStyleRight.Render(m.world.RenderPosition(m.playerID)),
 		),
 	)
@@ -457,6 +687,30 @@ func (m UIModel) View() string {
 	return docStyle.Render(doc.String())
 }
 
+// applyLayout recomputes the layout breakpoint and resizes everything that depends on it
+func (m UIModel) applyLayout() UIModel {
+	m.layout = newLayout(m.width, m.height)
+	if m.layout.size != compact {
+		m.pane = MapPane
+	}
+	chatHeight := m.height - 5 // -5 for chatInput
+	if m.layout.size == compact {
+		chatHeight = m.mainHeight() - 1
+	}
+	if chatHeight < 0 {
+		chatHeight = 0
+	}
+	m.chat.Resize(m.layout.chatViewportWidth(), chatHeight)
+	m.chatInput.Width = m.layout.chatViewportWidth() - 3
+	m.log.viewport.Width = m.mainWidth()
+	m.log.viewport.Height = util.ClampedInt(m.mainHeight()-2, 0, m.mainHeight())
+	return m
+}
+
 func (m UIModel) mainWidth() int {
-	return m.width - 20 - 2 - 20 - 2 // minus both sidebars and borders
+	return m.layout.mainWidth
+}
+
+func (m UIModel) mainHeight() int {
+	return m.layout.mainHeight
 }
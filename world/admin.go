@@ -0,0 +1,53 @@
+package world
+
+import "strings"
+
+// SetAdmins grants admin commands to the players with the given public keys, in authorized_keys
+// format. comments after the key are ignored.
+func (w *World) SetAdmins(keys []string) {
+	w.Lock()
+	defer w.Unlock()
+	w.admins = make(map[string]bool)
+	for _, k := range keys {
+		if k = normalizeKey(k); k != "" {
+			w.admins[k] = true
+		}
+	}
+}
+
+// SetModerators grants moderation commands to the players with the given public keys. admins are
+// always moderators.
+func (w *World) SetModerators(keys []string) {
+	w.Lock()
+	defer w.Unlock()
+	w.moderators = make(map[string]bool)
+	for _, k := range keys {
+		if k = normalizeKey(k); k != "" {
+			w.moderators[k] = true
+		}
+	}
+}
+
+// isAdmin reports whether the player may use admin commands. player ids are their public keys.
+func (w *World) isAdmin(playerID string) bool {
+	w.RLock()
+	defer w.RUnlock()
+	return w.admins[normalizeKey(playerID)]
+}
+
+// isModerator reports whether the player may use moderation commands
+func (w *World) isModerator(playerID string) bool {
+	w.RLock()
+	defer w.RUnlock()
+	k := normalizeKey(playerID)
+	return w.admins[k] || w.moderators[k]
+}
+
+// normalizeKey reduces an authorized_keys line to its type and data, eg "ssh-ed25519 AAAA..."
+func normalizeKey(k string) string {
+	f := strings.Fields(k)
+	if len(f) < 2 {
+		return ""
+	}
+	return f[0] + " " + f[1]
+}
@@ -0,0 +1,30 @@
+package world
+
+import (
+	"github.com/dustmason/nicefort/events"
+	"github.com/dustmason/nicefort/i18n"
+)
+
+// Bus is where structured world events are published, for anything that wants to react to them
+func (w *World) Bus() *events.Bus {
+	return w.bus
+}
+
+// publish puts a payload on the bus. actor is the id of the player who caused it, or "".
+func (w *World) publish(actor string, x, y int, p events.Payload) {
+	w.bus.Publish(events.Envelope{Actor: actor, X: x, Y: y, Payload: p})
+}
+
+// announce writes the bus events everyone should hear about into the world feed
+func (w *World) announce(e events.Envelope) {
+	switch p := e.Payload.(type) {
+	case events.PlayerJoined:
+		w.Event(events.Warning, i18n.M("%s joined.", p.Name))
+	case events.PlayerDied:
+		w.Event(events.Danger, i18n.M("RIP %s", p.Name))
+	case events.PlayerRespawned:
+		w.Event(events.Warning, i18n.M("%s washed ashore.", p.Name))
+	case events.SeasonChanged:
+		w.Event(events.Info, i18n.M("%s has begun", i18n.Name(p.Season)))
+	}
+}
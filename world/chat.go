@@ -0,0 +1,360 @@
+package world
+
+import (
+	"github.com/dustmason/nicefort/events"
+	"github.com/dustmason/nicefort/i18n"
+	"sort"
+	"strings"
+)
+
+const sayRadius = 20     // how far away players can hear you talk
+const shoutRadius = 60   // how far away players can hear you shout
+const shoutHunger = 0.01 // shouting is tiring
+
+// chatChannel decides who hears a chat message
+type chatChannel int
+
+const (
+	sayChannel chatChannel = iota
+	shoutChannel
+	globalChannel
+)
+
+var chatChannelNames = []string{"say", "shout", "global"}
+
+func (c chatChannel) String() string {
+	return chatChannelNames[c]
+}
+
+// radius is how far the channel carries, or 0 if it reaches everyone
+func (c chatChannel) radius() int {
+	switch c {
+	case sayChannel:
+		return sayRadius
+	case shoutChannel:
+		return shoutRadius
+	}
+	return 0
+}
+
+// chatCommand is a slash command that can be typed into the chat input
+type chatCommand struct {
+	usage  string // eg "/w <name> <message>"
+	help   string
+	speaks bool // sends a message to other players, so it's subject to muting and rate limits
+	run    func(w *World, e *entity, args string)
+}
+
+var chatCommands map[string]chatCommand
+
+// chatCommands refer to each other through /help, so they're set up in init to avoid an initialization cycle
+func init() {
+	chatCommands = map[string]chatCommand{
+		"w":       {usage: "/w <name> <message>", help: "whisper to one player", speaks: true, run: (*World).whisper},
+		"shout":   {usage: "/shout <message>", help: "be heard further away, at the cost of some hunger", speaks: true, run: (*World).shout},
+		"g":       {usage: "/g <message>", help: "talk to everyone on the island", speaks: true, run: (*World).global},
+		"global":  {usage: "/global on|off", help: "open or close the global channel (admins only)", run: (*World).toggleGlobal},
+		"mail":    {usage: "/mail <name> <message>", help: "leave a letter for a player, even if they're away", speaks: true, run: (*World).sendMail},
+		"me":      {usage: "/me <action>", help: "describe what you're doing", speaks: true, run: (*World).emote},
+		"who":     {usage: "/who", help: "list who is online and where", run: (*World).who},
+		"mute":    {usage: "/mute <name>", help: "hide or show a player's chat", run: (*World).mute},
+		"help":    {usage: "/help", help: "list chat commands", run: (*World).chatHelp},
+		"silence": {usage: "/silence <name> <duration>", help: "stop a player chatting for a while, 0 to lift (moderators only)", run: (*World).silence},
+		"kick":    {usage: "/kick <name>", help: "disconnect a player (moderators only)", run: (*World).kick},
+		"ban":     {usage: "/ban <name> <duration>", help: "keep a player out for a while, 0 to lift (moderators only)", run: (*World).ban},
+		"clear":   {usage: "/clear", help: "clear everyone's chat (moderators only)", run: (*World).clearChat},
+	}
+}
+
+// SendChat handles a line typed into the chat input. lines starting with "/" are commands, everything
+// else is said out loud to nearby players.
+func (w *World) SendChat(playerID, input string) {
+	input = strings.TrimSpace(input)
+	e, ok := w.getPlayer(playerID)
+	if !ok || input == "" {
+		return
+	}
+	if !strings.HasPrefix(input, "/") {
+		if w.allowChat(e) {
+			w.speak(e, sayChannel, input, false)
+		}
+		return
+	}
+	name, args, _ := strings.Cut(input[1:], " ")
+	cmd, ok := chatCommands[strings.ToLower(name)]
+	if !ok {
+		e.player.Event(events.Warning, events.Chat, i18n.M("Unknown command /%s. Try /help", name))
+		return
+	}
+	if cmd.speaks && !w.allowChat(e) {
+		return
+	}
+	cmd.run(w, e, strings.TrimSpace(args))
+}
+
+func (w *World) whisper(e *entity, args string) {
+	name, message, _ := strings.Cut(args, " ")
+	message = strings.TrimSpace(message)
+	if name == "" || message == "" {
+		e.player.Event(events.Warning, events.Chat, i18n.M("Usage: %s", chatCommands["w"].usage))
+		return
+	}
+	target, err := w.onlinePlayerNamed(name)
+	if err != nil {
+		notFound(e, name, err, "Nobody called %s is online")
+		return
+	}
+	message = w.filterChat(message)
+	e.player.Event(events.Info, events.Chat, i18n.M("You whisper to %s: %s", target.player.name, message))
+	if target.player.mutes(e.player.name) {
+		return // the sender isn't told, same as for any other muted chat
+	}
+	target.player.Event(events.Info, events.Chat, i18n.M("%s whispers: %s", e.player.name, message))
+	w.publish(e.player.id, e.player.loc.X, e.player.loc.Y, events.ChatMessage{Name: e.player.name, Channel: "whisper", To: target.player.name, Text: message})
+}
+
+func (w *World) emote(e *entity, args string) {
+	if args == "" {
+		e.player.Event(events.Warning, events.Chat, i18n.M("Usage: %s", chatCommands["me"].usage))
+		return
+	}
+	w.speak(e, sayChannel, args, true)
+}
+
+func (w *World) shout(e *entity, args string) {
+	if args == "" {
+		e.player.Event(events.Warning, events.Chat, i18n.M("Usage: %s", chatCommands["shout"].usage))
+		return
+	}
+	e.player.Exert(shoutHunger)
+	w.speak(e, shoutChannel, args, false)
+}
+
+func (w *World) global(e *entity, args string) {
+	if args == "" {
+		e.player.Event(events.Warning, events.Chat, i18n.M("Usage: %s", chatCommands["g"].usage))
+		return
+	}
+	w.speak(e, globalChannel, args, false)
+}
+
+func (w *World) toggleGlobal(e *entity, args string) {
+	if !w.isAdmin(e.player.id) {
+		e.player.Event(events.Warning, events.Chat, i18n.M("Only admins can do that"))
+		return
+	}
+	var open bool
+	switch strings.ToLower(args) {
+	case "on":
+		open = true
+	case "off":
+		open = false
+	default:
+		e.player.Event(events.Warning, events.Chat, i18n.M("Usage: %s", chatCommands["global"].usage))
+		return
+	}
+	w.Lock()
+	w.globalChat = open
+	w.Unlock()
+	if open {
+		w.Event(events.Info, i18n.M("The global channel is open"))
+	} else {
+		w.Event(events.Info, i18n.M("The global channel is closed"))
+	}
+}
+
+// speak sends a chat message (or an emote) on a channel. local channels reach the players within
+// their radius, and each of them sees which direction the speaker is in.
+func (w *World) speak(e *entity, ch chatChannel, message string, emote bool) {
+	add := (*events.EventList).AddChat
+	if emote {
+		add = (*events.EventList).AddEmote
+	}
+	message = w.filterChat(message)
+	x, y := e.player.GetLocation()
+	if ch == globalChannel {
+		w.RLock()
+		open := w.globalChat
+		w.RUnlock()
+		if !open {
+			e.player.Event(events.Warning, events.Chat, i18n.M("The global channel is closed"))
+			return
+		}
+		add(w.events, events.Info, i18n.M(ch.String()), i18n.Literal(message), e.player.name)
+		w.broadcastEvents()
+		w.publish(e.player.id, x, y, events.ChatMessage{Name: e.player.name, Channel: ch.String(), Text: message, Emote: emote})
+		return
+	}
+	add(e.player.chat, events.Info, i18n.M(ch.String()), i18n.Literal(message), e.player.name)
+	w.notifyFeed(e.player.id)
+	w.publish(e.player.id, x, y, events.ChatMessage{Name: e.player.name, Channel: ch.String(), Text: message, Emote: emote})
+	for _, o := range w.onlinePlayers() {
+		ox, oy := o.player.GetLocation()
+		if o == e || steps(x, y, ox, oy) > ch.radius() || o.player.mutes(e.player.name) {
+			continue
+		}
+		arrow, dist := compassIndicator(ox, oy, x, y)
+		channel := i18n.M("%s %s %d", i18n.Name(ch.String()), arrow, dist)
+		add(o.player.chat, events.Info, channel, i18n.Literal(message), e.player.name)
+		w.notifyFeed(o.player.id)
+	}
+}
+
+func (w *World) who(e *entity, _ string) {
+	online := w.onlinePlayers()
+	e.player.Event(events.Info, events.Chat, i18n.M("%d online", len(online)))
+	x, y := e.player.GetLocation()
+	for _, o := range online {
+		if o == e {
+			continue
+		}
+		ox, oy := o.player.GetLocation()
+		e.player.Event(events.Info, events.Chat, i18n.M("%s: %s %d", o.player.name, i18n.Name(direction(x, y, ox, oy)), steps(x, y, ox, oy)))
+	}
+}
+
+func (w *World) mute(e *entity, args string) {
+	if args == "" {
+		muted := e.player.muted.list()
+		if len(muted) == 0 {
+			e.player.Event(events.Info, events.Chat, i18n.M("You haven't muted anyone"))
+			return
+		}
+		e.player.Event(events.Info, events.Chat, i18n.M("Muted: %s", strings.Join(muted, ", ")))
+		return
+	}
+	muted := e.player.muted.toggle(args)
+	if muted {
+		e.player.Event(events.Info, events.Chat, i18n.M("You muted %s", args))
+	} else {
+		e.player.Event(events.Info, events.Chat, i18n.M("You unmuted %s", args))
+	}
+	w.notifyFeed(e.player.id)
+}
+
+func (w *World) silence(e *entity, args string) {
+	name, duration, _ := strings.Cut(args, " ")
+	d, ok := parseDuration(strings.TrimSpace(duration))
+	if !w.moderate(e, "silence", name != "" && ok) {
+		return
+	}
+	if err := w.Silence(e.player.id, name, d); err != nil {
+		notFound(e, name, err, "Nobody is called %s")
+	} else if d > 0 {
+		e.player.Event(events.Info, events.Chat, i18n.M("You muted %s for %s", name, formatDuration(d)))
+	} else {
+		e.player.Event(events.Info, events.Chat, i18n.M("%s can chat again", name))
+	}
+}
+
+func (w *World) kick(e *entity, args string) {
+	if !w.moderate(e, "kick", args != "") {
+		return
+	}
+	if err := w.Kick(e.player.id, args); err != nil {
+		notFound(e, args, err, "Nobody called %s is online")
+	}
+}
+
+func (w *World) ban(e *entity, args string) {
+	name, duration, _ := strings.Cut(args, " ")
+	d, ok := parseDuration(strings.TrimSpace(duration))
+	if !w.moderate(e, "ban", name != "" && ok) {
+		return
+	}
+	if err := w.Ban(e.player.id, name, d); err != nil {
+		notFound(e, name, err, "Nobody is called %s")
+	} else if d <= 0 {
+		e.player.Event(events.Info, events.Chat, i18n.M("%s is no longer banned", name))
+	}
+}
+
+func (w *World) clearChat(e *entity, _ string) {
+	if w.moderate(e, "clear", true) {
+		w.ClearChat(e.player.id)
+	}
+}
+
+// moderate checks that e may use the moderation command, and that its arguments made sense
+func (w *World) moderate(e *entity, command string, valid bool) bool {
+	if !w.isModerator(e.player.id) {
+		e.player.Event(events.Warning, events.Chat, i18n.M("Only moderators can do that"))
+		return false
+	}
+	if !valid {
+		e.player.Event(events.Warning, events.Chat, i18n.M("Usage: %s", chatCommands[command].usage))
+		return false
+	}
+	return true
+}
+
+func (w *World) chatHelp(e *entity, _ string) {
+	names := make([]string, 0, len(chatCommands))
+	for name := range chatCommands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		c := chatCommands[name]
+		e.player.Event(events.Info, events.Chat, i18n.M("%s: %s", c.usage, i18n.Name(c.help)))
+	}
+}
+
+// onlinePlayers lists the players that have a session open, sorted by name
+func (w *World) onlinePlayers() []*entity {
+	w.RLock()
+	defer w.RUnlock()
+	w.subsLock.RLock()
+	defer w.subsLock.RUnlock()
+	out := make([]*entity, 0, len(w.subscribers))
+	for id := range w.subscribers {
+		if e, ok := w.players[id]; ok {
+			out = append(out, e)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].player.name < out[j].player.name
+	})
+	return out
+}
+
+// onlinePlayerNamed finds an online player by name, ignoring case
+func (w *World) onlinePlayerNamed(name string) (*entity, error) {
+	return onlyNamed(w.onlinePlayers(), name)
+}
+
+// online reports whether the player has a session open
+func (w *World) online(playerID string) bool {
+	w.subsLock.RLock()
+	defer w.subsLock.RUnlock()
+	_, ok := w.subscribers[playerID]
+	return ok
+}
+
+// notFound tells e why name didn't find a player. missing is the message for when nobody has it.
+func notFound(e *entity, name string, err error, missing string) {
+	if err == ambiguousNameError {
+		e.player.Event(events.Warning, events.Chat, i18n.M("More than one player is called %s", name))
+		return
+	}
+	e.player.Event(events.Warning, events.Chat, i18n.M(missing, name))
+}
+
+// visibleFeed is the world feed merged with the local chat the player has heard, without chat from
+// players they've muted
+func (w *World) visibleFeed(v feedView) []events.Event {
+	if v.chat == nil {
+		return w.events.Events()
+	}
+	evs := events.Merge(w.events.Events(), v.chat.Events())
+	if v.muted.empty() {
+		return evs
+	}
+	out := make([]events.Event, 0, len(evs))
+	for _, ev := range evs {
+		if !v.muted.has(ev.Subject()) {
+			out = append(out, ev)
+		}
+	}
+	return out
+}
@@ -0,0 +1,31 @@
+package world
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+// run with -race: chat renders the feed for every session while other players join
+func TestChatWhilePlayersJoin(t *testing.T) {
+	w := NewWorld(64)
+	w.PlayerJoin("pat", "pat")
+	w.Subscribe("pat", func(Notification) {})
+	defer w.Unsubscribe("pat")
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 200; i++ {
+			id := fmt.Sprintf("player-%d", i)
+			w.PlayerJoin(id, id)
+		}
+	}()
+	for i := 0; i < 200; i++ {
+		w.SendChat("pat", fmt.Sprintf("/mute player-%d", i))
+	}
+	wg.Wait()
+	if !w.players["pat"].player.mutes("PLAYER-3") {
+		t.Errorf("pat didn't mute player-3")
+	}
+}
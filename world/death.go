@@ -0,0 +1,171 @@
+package world
+
+import (
+	"github.com/dustmason/nicefort/events"
+	"github.com/dustmason/nicefort/i18n"
+)
+
+// lifeStats counts what a player did with their current life, for the recap when they die
+type lifeStats struct {
+	kills    int
+	harvests int
+	crafted  int
+	steps    int
+}
+
+// Death is what the death screen shows about a player's last life
+type Death struct {
+	Cause    i18n.Message
+	Days     int // days survived
+	Kills    int
+	Harvests int
+	Crafted  int
+	Steps    int
+	KeepsMap bool // whether the player will remember the map when they respawn
+}
+
+// corpse holds what a player was carrying when they died, for anyone to loot
+type corpse struct {
+	name  string
+	items []*InventoryItem
+}
+
+var Corpse = newItem(
+	"corpse",
+	"remains",
+	"% ",
+	"#C1C6B2",
+	0,
+	0,
+	0,
+	true,
+	nil,
+)
+
+// SetKeepMapMemory decides whether players remember the map they explored when they respawn
+func (w *World) SetKeepMapMemory(keep bool) {
+	w.Lock()
+	defer w.Unlock()
+	w.keepMapMemory = keep
+}
+
+// deadPlayer returns a player who has died and not yet respawned
+func (w *World) deadPlayer(playerID string) (*player, bool) {
+	w.RLock()
+	defer w.RUnlock()
+	p, ok := w.deaths[playerID]
+	return p, ok
+}
+
+// Death describes how the player died, if they're dead
+func (w *World) Death(playerID string) (Death, bool) {
+	p, ok := w.deadPlayer(playerID)
+	if !ok {
+		return Death{}, false
+	}
+	return p.death, true
+}
+
+// PlayerDeath leaves the player's belongings in a corpse where they fell and takes them out of the
+// world until they respawn
+func (w *World) PlayerDeath(p *player) {
+	w.Lock()
+	defer w.Unlock()
+	x, y := p.GetLocation()
+	p.death = Death{
+		Cause:    p.cause,
+		Days:     int(w.days - p.born),
+		Kills:    p.life.kills,
+		Harvests: p.life.harvests,
+		Crafted:  p.life.crafted,
+		Steps:    p.life.steps,
+		KeepsMap: w.keepMapMemory,
+	}
+	i := w.index(x, y)
+	for _, e := range w.location(x, y) {
+		if e.player == p {
+			w.setLocation(i, removeEntity(w.wMap[i], e))
+			break
+		}
+	}
+	if len(p.inventory) > 0 {
+		items := make([]*InventoryItem, 0, len(p.inventory))
+		for _, ii := range p.inventory {
+			items = append(items, &InventoryItem{Item: ii.Item, Quantity: ii.Quantity, age: ii.age})
+		}
+		w.setLocation(i, addEntity(w.wMap[i], &entity{item: Corpse, quantity: 1, remains: &corpse{name: p.name, items: items}, droppedAt: w.days}))
+	}
+	p.ReplaceInventory(make(map[string]*InventoryItem))
+	delete(w.players, p.id)
+	w.deaths[p.id] = p
+	w.refreshActiveNPCs()
+	w.publish(p.id, x, y, events.PlayerDied{Name: p.name, Cause: p.cause.String(), Days: p.death.Days})
+	w.notify(p.id, Died)
+}
+
+// Respawn gives a dead player a fresh character somewhere new. their settings, mail and messages
+// carry over, and so does their memory of the map if the server keeps it.
+func (w *World) Respawn(playerID string) {
+	w.Lock()
+	old, ok := w.deaths[playerID]
+	if !ok {
+		w.Unlock()
+		return
+	}
+	delete(w.deaths, playerID)
+	x, y, _ := w.randomAvailableCoord()
+	e := NewPlayer(playerID, Coord{x, y})
+	p := e.player
+	p.name = old.name
+	p.notify = old.notify
+	p.onKick = old.onKick
+	p.events = old.events
+	p.chat = old.chat
+	p.timestamps = old.timestamps
+	p.locale = old.locale
+	p.muted = old.muted
+	p.silencedUntil = old.silencedUntil
+	p.recentChat = old.recentChat
+	p.mail = old.mail
+	p.nextLetterID = old.nextLetterID
+	p.born = w.days
+	if w.keepMapMemory {
+		p.mapMem = old.mapMem
+	}
+	w.players[playerID] = e
+	i := w.index(x, y)
+	w.setLocation(i, append(w.wMap[i], e))
+	p.See(w)
+	w.refreshActiveNPCs()
+	w.Unlock()
+	p.Event(events.Info, events.System, i18n.M("You wake on a strange shore with nothing but an empty pack"))
+	w.publish(playerID, x, y, events.PlayerRespawned{Name: p.name})
+	w.notify(playerID, MapChanged|StatsChanged)
+}
+
+// lootable finds a corpse at x, y
+func (w *World) lootable(x, y int) (*entity, bool) {
+	for _, e := range w.location(x, y) {
+		if e.remains != nil {
+			return e, true
+		}
+	}
+	return nil, false
+}
+
+// loot takes as much from a corpse as the player can carry. the corpse is gone once it's empty.
+func (w *World) loot(p *player, ent *entity, x, y int) {
+	i := w.index(x, y)
+	left := make([]*InventoryItem, 0)
+	for _, ii := range ent.remains.items {
+		ii.Quantity -= p.PickUp(ii.Item, ii.Quantity, w.aged(ii.Item, ii.age, ent.droppedAt, x, y))
+		if ii.Quantity > 0 {
+			left = append(left, ii)
+		}
+	}
+	ent.remains.items = left
+	if len(left) == 0 {
+		w.setLocation(i, removeEntity(w.wMap[i], ent))
+		p.Event(events.Info, events.Harvesting, i18n.M("You took the last of %s's belongings", ent.remains.name))
+	}
+}
@@ -0,0 +1,231 @@
+package world
+
+import (
+	"github.com/dustmason/nicefort/events"
+	"github.com/dustmason/nicefort/i18n"
+	"math"
+	"sort"
+	"strings"
+)
+
+// maxDescriptions caps how many things are described at once, so a screen reader isn't flooded
+const maxDescriptions = 12
+
+var environmentNames = map[Environment]string{
+	WallBlock: "wall",
+	Floor:     "floor",
+	Water:     "water",
+	Mud:       "mud",
+	Grass:     "grass",
+	Rock:      "rock",
+	Pebbles:   "pebbles",
+}
+
+// waterPriority is the priority of water, which is only described once per direction
+const waterPriority = 3
+
+// octantNames are compass directions, clockwise from east, matching the octants of atan2
+var octantNames = []string{"East", "South-east", "South", "South-west", "West", "North-west", "North", "North-east"}
+
+// direction names the compass direction from one point to another
+func direction(fromX, fromY, toX, toY int) string {
+	angle := math.Atan2(float64(toY-fromY), float64(toX-fromX)) // y grows southward
+	octant := int(math.Round(angle/(math.Pi/4))+8) % 8
+	return octantNames[octant]
+}
+
+// steps is the number of moves between two points, counting diagonal moves as one
+func steps(fromX, fromY, toX, toY int) int {
+	dx := toX - fromX
+	dy := toY - fromY
+	if dx < 0 {
+		dx = -dx
+	}
+	if dy < 0 {
+		dy = -dy
+	}
+	if dx > dy {
+		return dx
+	}
+	return dy
+}
+
+type description struct {
+	priority int // lower is described first
+	dist     int
+	dir      string
+	what     string
+}
+
+func (d description) in(l i18n.Locale) string {
+	return l.T("%s %d: %s.", i18n.Name(d.dir), d.dist, d.what)
+}
+
+// describe names an entity for text mode in the viewer's language, or returns "" if it isn't worth mentioning
+func (e *entity) describe(viewer *entity) (string, int) {
+	l := viewer.player.locale
+	switch {
+	case e.player != nil:
+		return e.player.name, 0
+	case e.npc != nil:
+		return l.T("%s (%s)", i18n.Name(e.npc.Name), i18n.Name(e.npc.describeState(viewer))), 0
+	case e.remains != nil:
+		return l.T("remains of %s", e.remains.name), 1
+	case e.item != nil:
+		return l.T("%d x %s", e.quantity, i18n.Name(e.item.Name)), 1
+	case e.flora != nil:
+		return l.T(e.flora.name), 2
+	case e.environment == Water:
+		return l.T("water"), waterPriority
+	}
+	return "", 0
+}
+
+func (n *NPC) describeState(viewer *entity) string {
+	_, targeted := n.targets[viewer]
+	switch {
+	case n.aggressive && targeted:
+		return "hostile, approaching"
+	case n.aggressive:
+		return "hostile"
+	case n.mood == terrorized:
+		return "fleeing"
+	case n.mood == asleep:
+		return "asleep"
+	}
+	return "calm"
+}
+
+// DescribeSurroundings lists what the player can see in plain text, nearest and most important first,
+// eg "North 3: Scots Pine". the first line describes the player's own tile.
+func (w *World) DescribeSurroundings(playerID string) []string {
+	e, ok := w.getPlayer(playerID)
+	if !ok {
+		return nil
+	}
+	x, y := e.player.GetLocation()
+	visible := e.player.VisiblePoints()
+	l := e.player.locale
+
+	w.RLock()
+	defer w.RUnlock()
+	out := []string{w.describeHere(e, x, y)}
+	found := make([]description, 0)
+	for _, c := range visible {
+		if c.X == x && c.Y == y || !w.InBounds(c.X, c.Y) {
+			continue
+		}
+		loc := w.location(c.X, c.Y)
+		top := loc[len(loc)-1]
+		text, priority := top.describe(e)
+		if text == "" {
+			continue
+		}
+		found = append(found, description{
+			priority: priority,
+			dist:     steps(x, y, c.X, c.Y),
+			dir:      direction(x, y, c.X, c.Y),
+			what:     text,
+		})
+	}
+	sort.Slice(found, func(i, j int) bool {
+		if found[i].priority != found[j].priority {
+			return found[i].priority < found[j].priority
+		}
+		if found[i].dist != found[j].dist {
+			return found[i].dist < found[j].dist
+		}
+		return found[i].in(l) < found[j].in(l)
+	})
+	waterSeen := make(map[string]bool) // only mention the nearest water in each direction
+	for _, d := range found {
+		if len(out) > maxDescriptions {
+			break
+		}
+		if d.priority == waterPriority {
+			if waterSeen[d.dir] {
+				continue
+			}
+			waterSeen[d.dir] = true
+		}
+		out = append(out, d.in(l))
+	}
+	if len(out) == 1 {
+		out = append(out, l.T("Nothing else in sight."))
+	}
+	return out
+}
+
+func (w *World) describeHere(e *entity, x, y int) string {
+	loc := w.location(x, y)
+	l := e.player.locale
+	ground := environmentNames[loc[0].environment]
+	if ground == "" {
+		ground = "the ground"
+	}
+	var b strings.Builder
+	b.WriteString(l.T("You stand on %s.", i18n.Name(ground)))
+	for _, ent := range loc[1:] {
+		if ent == e {
+			continue
+		}
+		if text, _ := ent.describe(e); text != "" {
+			b.WriteString(" " + l.T("Here: %s.", text))
+		}
+	}
+	return b.String()
+}
+
+// DescribePlayer summarizes the player's own state in plain text
+func (w *World) DescribePlayer(playerID string) string {
+	e, ok := w.getPlayer(playerID)
+	if !ok {
+		return ""
+	}
+	p := e.player
+	s := p.locale.T(
+		"Health %d of %d. %s, %s, %s, %s. Pack %.1f of %d. Wielding %s.",
+		p.health, p.maxHealth, i18n.Name(hungerNeed.labels[p.hungerLevel]), i18n.Name(thirstNeed.labels[p.thirstLevel]),
+		i18n.Name(coldNeed.labels[p.coldLevel]), i18n.Name(fatigueNeed.labels[p.fatigueLevel]),
+		p.carrying, int(p.maxCarry), i18n.Name(p.wielding.Name),
+	)
+	if p.overloaded() {
+		s += " " + p.locale.T("Overloaded, moving at %d%% speed.", p.speed())
+	} else if speed := p.speed(); speed < 100 {
+		s += " " + p.locale.T("Moving at %d%% speed.", speed)
+	}
+	if skills := p.renderSkills(p.locale); len(skills) > 0 {
+		s += " " + p.locale.T("Skills: %s.", strings.Join(skills, ", "))
+	}
+	if names := p.effects.names(p.locale); len(names) > 0 {
+		s += " " + p.locale.T("Effects: %s.", strings.Join(names, ", "))
+	}
+	if p.rest != awake {
+		s += " " + p.locale.T("%s.", i18n.Name(p.rest.String()))
+	}
+	if a := p.GetActivity(); a.description != "" && a.progress < 1 {
+		s += " " + p.locale.T("Working on %s, %d%% done.", i18n.Name(a.description), int(a.progress*100))
+	}
+	return s
+}
+
+// RecentEvents returns the n most recent events from the player's feed and the world feed as plain
+// text, oldest first
+func (w *World) RecentEvents(playerID string, n int) []string {
+	e, ok := w.getPlayer(playerID)
+	if !ok {
+		return nil
+	}
+	w.RLock()
+	v := e.player.feedView()
+	w.RUnlock()
+	evs := events.Merge(e.player.events.Last(n), w.visibleFeed(v))
+	if len(evs) > n {
+		evs = evs[len(evs)-n:]
+	}
+	out := make([]string, 0, n)
+	for _, ev := range evs {
+		out = append(out, ev.Text(v.locale))
+	}
+	return out
+}
@@ -0,0 +1,46 @@
+package world
+
+import "testing"
+
+func TestDirection(t *testing.T) {
+	tests := []struct {
+		toX, toY int
+		want     string
+	}{
+		{1, 0, "East"},
+		{1, 1, "South-east"},
+		{0, 1, "South"},
+		{-1, 1, "South-west"},
+		{-1, 0, "West"},
+		{-1, -1, "North-west"},
+		{0, -1, "North"},
+		{1, -1, "North-east"},
+		{5, 1, "East"},       // mostly east
+		{5, 3, "South-east"}, // closer to the diagonal
+		{-2, -9, "North"},
+	}
+	for _, tt := range tests {
+		if got := direction(10, 10, 10+tt.toX, 10+tt.toY); got != tt.want {
+			t.Errorf("direction to %+d, %+d = %s, want %s", tt.toX, tt.toY, got, tt.want)
+		}
+	}
+}
+
+func TestSteps(t *testing.T) {
+	tests := []struct {
+		fromX, fromY, toX, toY int
+		want                   int
+	}{
+		{0, 0, 0, 0, 0},
+		{0, 0, 3, 0, 3},
+		{0, 0, 0, -4, 4},
+		{0, 0, 3, 3, 3},
+		{5, 5, 2, 9, 4},
+		{-1, -1, 1, 1, 2},
+	}
+	for _, tt := range tests {
+		if got := steps(tt.fromX, tt.fromY, tt.toX, tt.toY); got != tt.want {
+			t.Errorf("steps(%d, %d, %d, %d) = %d, want %d", tt.fromX, tt.fromY, tt.toX, tt.toY, got, tt.want)
+		}
+	}
+}
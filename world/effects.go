@@ -0,0 +1,337 @@
+package world
+
+import (
+	"github.com/charmbracelet/lipgloss"
+	"github.com/dustmason/nicefort/events"
+	"github.com/dustmason/nicefort/i18n"
+	"math"
+	"strings"
+	"sync"
+	"time"
+)
+
+const bleedChance = 0.5 // that an animal's attack leaves the player bleeding
+const biteChance = 0.1  // that a step through the bog in summer gets the player bitten
+
+// stacking is what happens when an effect is applied to something that already has it
+type stacking int
+
+const (
+	stackRefresh   stacking = iota // the duration starts over
+	stackExtend                    // the duration is added to what's left
+	stackIntensify                 // another stack is added, up to maxStacks, and the duration starts over
+)
+
+// afflicted is anything that can have effects, ie players and NPCs
+type afflicted interface {
+	hurt(w *World, damage int, message, cause i18n.Message)
+}
+
+// effectKind describes a temporary state such as bleeding or being well fed. the multipliers are
+// 1 (or 0 for warmth) when the effect doesn't change that stat, and apply once per stack.
+type effectKind struct {
+	name      string
+	color     string
+	duration  time.Duration
+	stacking  stacking
+	maxStacks int
+	slowdown  float64 // multiplies the time between moves
+	hunger    float64 // multiplies how fast hunger grows
+	strength  float64 // multiplies harvesting power
+	warmth    float64 // °C added to how warm it feels
+	interval  time.Duration
+	onTick    func(w *World, a afflicted, stacks int) // runs every interval, if set
+	onset     string                                  // told to a player when they get the effect
+	wearsOff  string                                  // and when it's gone
+}
+
+type activeEffect struct {
+	kind     *effectKind
+	stacks   int
+	ends     time.Time
+	lastTick time.Time
+}
+
+// effects holds whatever is currently affecting a player or NPC. it's changed by sessions and by the
+// world ticker, so it has its own lock.
+type effects struct {
+	sync.Mutex
+	active []*activeEffect
+}
+
+// apply adds an effect, or stacks it onto one that's already active. it returns true if the effect
+// is new.
+func (es *effects) apply(k *effectKind, now time.Time) bool {
+	es.Lock()
+	defer es.Unlock()
+	for _, ae := range es.active {
+		if ae.kind != k {
+			continue
+		}
+		switch k.stacking {
+		case stackExtend:
+			ae.ends = ae.ends.Add(k.duration)
+		case stackIntensify:
+			if ae.stacks < k.maxStacks {
+				ae.stacks++
+			}
+			ae.ends = now.Add(k.duration)
+		default:
+			ae.ends = now.Add(k.duration)
+		}
+		return false
+	}
+	es.active = append(es.active, &activeEffect{kind: k, stacks: 1, ends: now.Add(k.duration), lastTick: now})
+	return true
+}
+
+// remove ends an effect early. it returns true if the effect was active.
+func (es *effects) remove(k *effectKind) bool {
+	es.Lock()
+	defer es.Unlock()
+	for i, ae := range es.active {
+		if ae.kind == k {
+			es.active = append(es.active[:i], es.active[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (es *effects) has(k *effectKind) bool {
+	es.Lock()
+	defer es.Unlock()
+	for _, ae := range es.active {
+		if ae.kind == k {
+			return true
+		}
+	}
+	return false
+}
+
+// tick runs the per-tick hooks of every effect and drops the ones that have run out, returning them.
+// the hooks run after the lock is released, since they can hurt and even kill.
+func (es *effects) tick(w *World, a afflicted, now time.Time) []*effectKind {
+	type due struct {
+		onTick func(*World, afflicted, int)
+		stacks int
+	}
+	es.Lock()
+	hooks := make([]due, 0)
+	expired := make([]*effectKind, 0)
+	kept := make([]*activeEffect, 0, len(es.active))
+	for _, ae := range es.active {
+		if ae.kind.onTick != nil && now.Sub(ae.lastTick) >= ae.kind.interval {
+			ae.lastTick = now
+			hooks = append(hooks, due{ae.kind.onTick, ae.stacks})
+		}
+		if now.After(ae.ends) {
+			expired = append(expired, ae.kind)
+			continue
+		}
+		kept = append(kept, ae)
+	}
+	es.active = kept
+	es.Unlock()
+	for _, h := range hooks {
+		h.onTick(w, a, h.stacks)
+	}
+	return expired
+}
+
+// multiplier combines one of the effects' multipliers, eg slowdown
+func (es *effects) multiplier(of func(*effectKind) float64) float64 {
+	es.Lock()
+	defer es.Unlock()
+	m := 1.0
+	for _, ae := range es.active {
+		if v := of(ae.kind); v != 0 {
+			m *= math.Pow(v, float64(ae.stacks))
+		}
+	}
+	return m
+}
+
+func (es *effects) slowdown() float64 {
+	return es.multiplier(func(k *effectKind) float64 { return k.slowdown })
+}
+
+func (es *effects) hunger() float64 {
+	return es.multiplier(func(k *effectKind) float64 { return k.hunger })
+}
+
+func (es *effects) strength() float64 {
+	return es.multiplier(func(k *effectKind) float64 { return k.strength })
+}
+
+func (es *effects) warmth() float64 {
+	es.Lock()
+	defer es.Unlock()
+	w := 0.
+	for _, ae := range es.active {
+		w += ae.kind.warmth * float64(ae.stacks)
+	}
+	return w
+}
+
+// names lists the active effects in the given language, with their stacks, eg "Bleeding x2"
+func (es *effects) names(l i18n.Locale) []string {
+	es.Lock()
+	defer es.Unlock()
+	return es.namesLocked(l)
+}
+
+func (es *effects) namesLocked(l i18n.Locale) []string {
+	out := make([]string, 0, len(es.active))
+	for _, ae := range es.active {
+		n := l.T(ae.kind.name)
+		if ae.stacks > 1 {
+			n += l.T(" x%d", ae.stacks)
+		}
+		out = append(out, n)
+	}
+	return out
+}
+
+// badges renders the active effects for the sidebar, one per line
+func (es *effects) badges(l i18n.Locale) string {
+	es.Lock()
+	defer es.Unlock()
+	names := es.namesLocked(l)
+	for i, ae := range es.active {
+		names[i] = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#000000")).
+			Background(lipgloss.Color(ae.kind.color)).
+			Padding(0, 1).
+			Render(names[i])
+	}
+	return strings.Join(names, "\n")
+}
+
+// afflict gives the player an effect, telling them about it if it's new
+func (p *player) afflict(k *effectKind) {
+	if p.effects.apply(k, time.Now()) {
+		if k.onset != "" {
+			p.Event(events.Warning, events.System, i18n.M(k.onset))
+		}
+		p.changed(StatsChanged)
+	} else if k.stacking == stackIntensify {
+		p.changed(StatsChanged) // the badge shows the stacks
+	}
+}
+
+// relieve ends an effect on the player early
+func (p *player) relieve(k *effectKind) {
+	if p.effects.remove(k) {
+		if k.wearsOff != "" {
+			p.Event(events.Info, events.System, i18n.M(k.wearsOff))
+		}
+		p.changed(StatsChanged)
+	}
+}
+
+// tickEffects runs the player's effects and tells them about the ones that wear off
+func (p *player) tickEffects(w *World, t time.Time) {
+	expired := p.effects.tick(w, p, t)
+	for _, k := range expired {
+		if k.wearsOff != "" {
+			p.Event(events.Info, events.System, i18n.M(k.wearsOff))
+		}
+	}
+	if len(expired) > 0 {
+		p.changed(StatsChanged)
+	}
+}
+
+func (p *player) hurt(w *World, damage int, message, cause i18n.Message) {
+	p.Attacked(w, damage, message, cause)
+}
+
+// hurt wounds an NPC but never kills it, since there's no one to collect what it drops
+func (n *NPC) hurt(w *World, damage int, message, cause i18n.Message) {
+	n.health -= damage
+	if n.health < 1 {
+		n.health = 1
+	}
+}
+
+// damageOverTime is an onTick hook that takes damage for each stack
+func damageOverTime(message, cause string) func(*World, afflicted, int) {
+	return func(w *World, a afflicted, stacks int) {
+		a.hurt(w, stacks, i18n.M(message, stacks), i18n.M(cause))
+	}
+}
+
+var bleeding = &effectKind{
+	name:      "Bleeding",
+	color:     "#FF5F5F",
+	duration:  30 * time.Second,
+	stacking:  stackIntensify,
+	maxStacks: 3,
+	slowdown:  1.1,
+	interval:  5 * time.Second,
+	onTick:    damageOverTime("You're bleeding. You lost %d health", "Bled to death"),
+	onset:     "You're bleeding!",
+	wearsOff:  "Your wounds have stopped bleeding",
+}
+
+var poisoned = &effectKind{
+	name:      "Poisoned",
+	color:     "#9BFF7C",
+	duration:  time.Minute,
+	stacking:  stackIntensify,
+	maxStacks: 3,
+	strength:  0.8,
+	interval:  10 * time.Second,
+	onTick:    damageOverTime("You feel sick to your stomach. You lost %d health", "Poisoned"),
+	onset:     "You've been poisoned",
+	wearsOff:  "The poison has worked its way out of you",
+}
+
+var wellFed = &effectKind{
+	name:     "Well fed",
+	color:    "#7BC96F",
+	duration: 2 * time.Minute,
+	stacking: stackExtend,
+	hunger:   0.5,
+	warmth:   2,
+	onset:    "You're well fed",
+}
+
+var warmedByFire = &effectKind{
+	name:     "Warmed by fire",
+	color:    "#FFB07C",
+	duration: time.Minute,
+	stacking: stackRefresh,
+	warmth:   10,
+	wearsOff: "The fire's warmth has left you",
+}
+
+var wet = &effectKind{
+	name:     "Wet",
+	color:    "#7CC4FF",
+	duration: time.Minute,
+	stacking: stackRefresh,
+	warmth:   -8,
+	onset:    "You're soaked through",
+	wearsOff: "You've dried off",
+}
+
+var insectBitten = &effectKind{
+	name:      "Insect bitten",
+	color:     "#FDFF8C",
+	duration:  90 * time.Second,
+	stacking:  stackIntensify,
+	maxStacks: 3,
+	strength:  0.9,
+	onset:     "Insects from the bog are biting you",
+	wearsOff:  "The bites have stopped itching",
+}
+
+var exhausted = &effectKind{
+	name:     "Exhausted",
+	color:    "#C77CFF",
+	duration: 30 * time.Second,
+	stacking: stackRefresh,
+	hunger:   1.5,
+}
@@ -0,0 +1,53 @@
+package world
+
+import (
+	"github.com/charmbracelet/lipgloss"
+	"github.com/dustmason/nicefort/events"
+	"github.com/dustmason/nicefort/i18n"
+	"math"
+)
+
+const overloadLimit = 1.5 // a player can pick up this many times their capacity, but barely move
+const fullSlowdown = 2.   // how much slower a player with a full pack moves
+const overloadSlowdown = 5.
+
+var overloadedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#FF5F5F"))
+
+// load is how full the player's pack is, where 1 is at capacity
+func (p *player) load() float64 {
+	return p.carrying / p.maxCarry
+}
+
+func (p *player) overloaded() bool {
+	return p.carrying > p.maxCarry
+}
+
+// loadSlowdown multiplies the time between moves. a light pack barely matters, but it gets steep as
+// the pack fills. past capacity the player is overloaded and slows to a crawl.
+func (p *player) loadSlowdown() float64 {
+	l := p.load()
+	if l <= 1 {
+		return 1 + (fullSlowdown-1)*math.Pow(l, 3)
+	}
+	return overloadSlowdown * (1 + 2*(l-1))
+}
+
+// slowdown combines everything that makes the player move slower than normal
+func (p *player) slowdown() float64 {
+	return p.loadSlowdown() * p.worstNeed().slowdown() * p.effects.slowdown()
+}
+
+// speed is how fast the player moves compared to normal, as a percentage
+func (p *player) speed() int {
+	return int(math.Round(100 / p.slowdown()))
+}
+
+// warnLoad tells the player when picking something up or dropping it changes whether they're
+// overloaded
+func (p *player) warnLoad(wasOverloaded bool) {
+	if now := p.overloaded(); now && !wasOverloaded {
+		p.Event(events.Warning, events.System, i18n.M("Your pack is overloaded. You can barely move"))
+	} else if !now && wasOverloaded {
+		p.Event(events.Info, events.System, i18n.M("Your pack is no longer overloaded"))
+	}
+}
@@ -0,0 +1,63 @@
+package world
+
+import "testing"
+
+func TestLoadSlowdown(t *testing.T) {
+	tests := []struct {
+		carrying float64
+		want     float64
+	}{
+		{0, 1},
+		{25, 1.125},
+		{50, fullSlowdown},
+		{60, overloadSlowdown * 1.4},
+		{75, overloadSlowdown * 2},
+	}
+	for _, tt := range tests {
+		p := NewPlayer("p", Coord{}).player
+		p.carrying = tt.carrying
+		if got := p.loadSlowdown(); got != tt.want {
+			t.Errorf("loadSlowdown() carrying %.1f = %v, want %v", tt.carrying, got, tt.want)
+		}
+	}
+}
+
+func TestLoadSlowdownIncreases(t *testing.T) {
+	p := NewPlayer("p", Coord{}).player
+	prev := 0.
+	for c := 0.; c <= p.maxCarry*overloadLimit; c++ {
+		p.carrying = c
+		got := p.loadSlowdown()
+		if got < prev {
+			t.Fatalf("loadSlowdown() carrying %.0f = %v, less than %v", c, got, prev)
+		}
+		prev = got
+	}
+}
+
+func TestPickUp(t *testing.T) {
+	tests := []struct {
+		name     string
+		carrying float64
+		quantity int
+		want     int
+	}{
+		{"empty pack", 0, 10, 10},
+		{"into overload", 49, 100, 100},
+		{"up to the overload limit", 74, 100, 20},
+		{"at the overload limit", 75, 10, 0},
+		{"past the overload limit", 90, 10, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewPlayer("p", Coord{}).player
+			p.carrying = tt.carrying
+			if got := p.PickUp(Twine, tt.quantity, 0); got != tt.want {
+				t.Errorf("PickUp() = %d, want %d", got, tt.want)
+			}
+			if got := p.carrying; got > p.maxCarry*overloadLimit && tt.want > 0 {
+				t.Errorf("carrying %.2f, over the overload limit", got)
+			}
+		})
+	}
+}
@@ -3,8 +3,10 @@ package world
 import (
 	"errors"
 	"fmt"
+	"github.com/dustmason/nicefort/i18n"
 	"github.com/lucasb-eyer/go-colorful"
 	"math"
+	"math/rand"
 )
 
 type entity struct {
@@ -14,7 +16,10 @@ type entity struct {
 	flora       *Flora
 	environment Environment
 	quantity    int
-	variant     int // 0 < n < 10, random value decided at worldgen time to use for visual texture
+	remains     *corpse // set on a Corpse item, for what can be looted from it
+	age         float64 // of perishable items, when they were dropped
+	droppedAt   float64 // w.days when a perishable item or corpse was left here
+	variant     int     // 0 < n < 10, random value decided at worldgen time to use for visual texture
 }
 
 var black, _ = colorful.Hex("#000000")
@@ -108,7 +113,7 @@ func (e entity) Walkable() bool {
 }
 
 func (e entity) Pickupable() bool {
-	return e.item != nil
+	return e.item != nil && e.remains == nil
 }
 
 func (e entity) baseColor() colorful.Color {
@@ -161,17 +166,23 @@ func (e entity) Attacked(w *World, attacker *entity, damage int) error {
 	if attacker.npc == nil || e.player == nil {
 		return fmt.Errorf("unsupported attack scenario. attacker: %s, target: %s", attacker, e)
 	}
+	e.player.wake(i18n.M("The %s is on you! You scramble to your feet", i18n.Name(attacker.npc.Name)))
 	if damage > 0 {
 		e.player.Attacked(
 			w,
 			damage,
-			fmt.Sprintf("The %s attacked you! You lost %d health", attacker.npc.Name, damage),
+			i18n.M("The %s attacked you! You lost %d health", i18n.Name(attacker.npc.Name), damage),
+			i18n.M("Killed by a %s", i18n.Name(attacker.npc.Name)),
 		)
+		if !e.player.dead && rand.Float64() < bleedChance {
+			e.player.afflict(bleeding)
+		}
 	} else {
 		e.player.Attacked(
 			w,
 			0,
-			fmt.Sprintf("The %s missed!", attacker.npc.Name),
+			i18n.M("The %s missed!", i18n.Name(attacker.npc.Name)),
+			i18n.M("Killed by a %s", i18n.Name(attacker.npc.Name)),
 		)
 	}
 	return nil
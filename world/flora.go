@@ -1,6 +1,9 @@
 package world
 
-import "sync"
+import (
+	"github.com/dustmason/nicefort/i18n"
+	"sync"
+)
 
 type Flora struct {
 	id          string
@@ -12,20 +15,21 @@ type Flora struct {
 	walkable    bool
 }
 
-// given an item (wielded by player) return:
+// given an item (wielded by player) and the player's strength (1 is full strength) return:
 // - bool: if the flora is now `dead`
 // - bool: successful attempt
 // - float64: amount of progress
 // - []InventoryItem: items dropped
-type harvestFunc func(*Item) (bool, bool, float64, []InventoryItem)
+type harvestFunc func(*Item, float64) (bool, bool, float64, []InventoryItem)
 
 func (f Flora) String() string {
 	return f.icon
 }
 
-// Harvest accepts an item wielded by the player. It has the same returns as harvestFunc
-func (f *Flora) Harvest(with *Item) (bool, bool, float64, []InventoryItem) {
-	return f.harvestFunc(with)
+// Harvest accepts an item wielded by the player, and the player's strength which scales the item's
+// power. It has the same returns as harvestFunc
+func (f *Flora) Harvest(with *Item, strength float64) (bool, bool, float64, []InventoryItem) {
+	return f.harvestFunc(with, strength)
 }
 
 type product struct {
@@ -38,7 +42,7 @@ type product struct {
 func withHarvestFunc(products ...product) harvestFunc {
 	state := make(map[ItemTraits]float64)
 	lock := sync.Mutex{}
-	return func(i *Item) (bool, bool, float64, []InventoryItem) {
+	return func(i *Item, strength float64) (bool, bool, float64, []InventoryItem) {
 		lock.Lock()
 		defer lock.Unlock()
 		for _, p := range products {
@@ -47,7 +51,7 @@ func withHarvestFunc(products ...product) harvestFunc {
 					// already exhausted this product
 					return false, false, 0., nil
 				}
-				state[p.with] += i.Power()
+				state[p.with] += i.Power() * strength
 				if state[p.with] < 1.0 {
 					return false, true, state[p.with], nil
 				}
@@ -71,7 +75,7 @@ func newFlora(id, name, icon, color string, walkable bool, h harvestFunc) *Flora
 	}
 }
 
-func newFloraProduct(id, name, icon, color string, weight float64, traits ItemTraits, activate func(*Item, *entity, *World) (bool, string)) *Item {
+func newFloraProduct(id, name, icon, color string, weight float64, traits ItemTraits, activate func(*Item, *entity, *World) (bool, i18n.Message)) *Item {
 	return &Item{ID: id, Name: name, Weight: weight, icon: icon, color: color, traits: traits, power: 0, activate: activate}
 }
 
@@ -121,7 +125,7 @@ func NorwaySpruce() *Flora {
 }
 
 var SpruceWood = newFloraProduct("spruce-wood", "Spruce Wood", "==", "#C0A18C", 0.75, Fuel, nil)
-var SpruceShoots = newFloraProduct("spruce-shoots", "Spruce Shoots", "u ", "#5B7B1A", 0.01, 0, nil)
+var SpruceShoots = newFloraProduct("spruce-shoots", "Spruce Shoots", "u ", "#5B7B1A", 0.01, 0, activateHerb)
 
 // It is also a popular animal bedding
 // 0-100m?
@@ -178,7 +182,7 @@ func BirdCherry() *Flora {
 }
 
 var BirdCherryWood = newFloraProduct("bird-cherry-wood", "Bird Cherry Wood", "==", "#A77235", 0.75, Fuel, nil)
-var BirdCherries = newFloraProduct("bird-cherries", "Bird Cherries", "፝፝", "#0C1A1B", 0.05, 0, nil)
+var BirdCherries = perishable(newFloraProduct("bird-cherries", "Bird Cherries", "፝፝", "#0C1A1B", 0.05, Edible, ActivateEdible(0.04, "The bird cherries are bitter, and the stones don't agree with you", poisoned)), 4)
 
 // DownyBirch
 // The outer layer of bark can be stripped off the tree without killing it and can be used to make canoe skins, drinking vessels and roofing tiles.
@@ -223,7 +227,7 @@ func BogMyrtle() *Flora {
 	)
 }
 
-var BogMyrtleLeaves = newFloraProduct("bog-myrtle-leaves", "Bog Myrtle Leaves", "..", "#778872", 0.01, 0, nil)
+var BogMyrtleLeaves = newFloraProduct("bog-myrtle-leaves", "Bog Myrtle Leaves", "..", "#778872", 0.01, 0, activateHerb)
 
 // GoatWillow
 // In Scandinavia it has been fairly common to make willow flutes from goat willow cuttings.
@@ -257,7 +261,7 @@ func GlaucousWillow() *Flora {
 	)
 }
 
-var GlaucousWillowCatkins = newFloraProduct("glaucous-willow-catkins", "Glaucous Willow Catkins", ",,", "#CFBDA8", 0.01, 0, nil)
+var GlaucousWillowCatkins = newFloraProduct("glaucous-willow-catkins", "Glaucous Willow Catkins", ",,", "#CFBDA8", 0.01, 0, activateHerb)
 
 // HalberdLeavedWillow
 // Native Americans used parts of willows, including this species, for medicinal purposes, basket weaving, to make bows and arrows, and for building animal traps.
@@ -277,7 +281,7 @@ func HalberdLeavedWillow() *Flora {
 }
 
 var HalberdLeavedWillowSticks = newFloraProduct("halberd-leaved-willow-sticks", "Halberd Leaved Willow Sticks", "--", "#7E9D0B", 0.1, Stick, nil)
-var HalberdLeavedWillowLeaves = newFloraProduct("halberd-leaved-willow-leaves", "Halberd Leaved Willow Leaves", "o ", "#7E9D0B", 0.01, 0, nil)
+var HalberdLeavedWillowLeaves = newFloraProduct("halberd-leaved-willow-leaves", "Halberd Leaved Willow Leaves", "o ", "#7E9D0B", 0.01, 0, activateHerb)
 
 // CloudberryBush
 func CloudberryBush() *Flora {
@@ -293,4 +297,4 @@ func CloudberryBush() *Flora {
 	)
 }
 
-var Cloudberries = newFloraProduct("cloudberries", "Cloudberries", ". ", "#FAB3BD", 0.01, 0, ActivateEdible(0.05, "You ate a handful of delicious cloudberries"))
+var Cloudberries = perishable(newFloraProduct("cloudberries", "Cloudberries", ". ", "#FAB3BD", 0.01, Edible, ActivateEdible(0.05, "You ate a handful of delicious cloudberries")), 3)
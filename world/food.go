@@ -0,0 +1,215 @@
+package world
+
+import (
+	"github.com/dustmason/nicefort/events"
+	"github.com/dustmason/nicefort/i18n"
+	"time"
+)
+
+const staleAge = 0.5       // food past this much of its shelf life is stale, and less nourishing
+const freezingTemp = 0.    // °C. frozen food barely spoils
+const coolTemp = 8.        // °C. cool food spoils slower
+const frozenSpoilage = 0.1 // how fast frozen food spoils, compared to normal
+const coolSpoilage = 0.5
+const cookingRadius = 1 // how close to a fire food is cooked
+
+// freshness is how far along a stack of food is toward spoiling
+type freshness int
+
+const (
+	fresh freshness = iota
+	stale
+	spoiled
+)
+
+var freshnessNames = []string{"Fresh", "Stale", "Spoiled"}
+
+func (f freshness) String() string {
+	return freshnessNames[f]
+}
+
+// perishable makes an item spoil after shelfLife days
+func perishable(i *Item, shelfLife float64) *Item {
+	i.shelfLife = shelfLife
+	return i
+}
+
+func (i Item) Perishable() bool {
+	return i.shelfLife > 0
+}
+
+func (ii InventoryItem) freshness() freshness {
+	switch {
+	case ii.age >= 1:
+		return spoiled
+	case ii.age >= staleAge:
+		return stale
+	}
+	return fresh
+}
+
+// add puts quantity more of the item in the stack. the stack's age is averaged, so a handful of fresh
+// berries freshens up an old stack a little.
+func (ii *InventoryItem) add(quantity int, age float64) {
+	if total := ii.Quantity + quantity; total > 0 && ii.Item.Perishable() {
+		ii.age = (ii.age*float64(ii.Quantity) + age*float64(quantity)) / float64(total)
+	}
+	ii.Quantity += quantity
+}
+
+// spoilage is how fast food spoils in the temperature at x, y, compared to normal
+func (w *World) spoilage(x, y int) float64 {
+	switch t := w.ambientTemperature(x, y); {
+	case t < freezingTemp:
+		return frozenSpoilage
+	case t < coolTemp:
+		return coolSpoilage
+	}
+	return 1
+}
+
+// spoil ages the food the player carries, telling them when something goes off
+func (p *player) spoil(w *World, elapsed float64) {
+	x, y := p.GetLocation()
+	days := elapsed / secondsPerDay * w.spoilage(x, y)
+	for _, ii := range p.inventory {
+		if !ii.Item.Perishable() {
+			continue
+		}
+		before := ii.freshness()
+		ii.age += days / ii.Item.shelfLife
+		if after := ii.freshness(); after != before {
+			if after == spoiled {
+				p.Event(events.Warning, events.System, i18n.M("Your %s has spoiled", i18n.Name(ii.Item.Name)))
+			}
+			p.changed(StatsChanged)
+		}
+	}
+}
+
+// aged is the age of food that was left at x, y since the day given. it spoils as fast as the place
+// it's lying in is warm right now, so a cache in the cold keeps longer.
+func (w *World) aged(i *Item, age, since float64, x, y int) float64 {
+	if !i.Perishable() || since == 0 {
+		return age
+	}
+	return age + (w.days-since)*w.spoilage(x, y)/i.shelfLife
+}
+
+// eat feeds the player from the stack of food in their inventory. stale food is less nourishing, and
+// spoiled food makes them sick.
+func (p *player) eat(i *Item, nutrition float64) {
+	ii, ok := p.inventoryMap[i.ID]
+	if !ok || !i.Perishable() {
+		p.Eat(nutrition)
+		return
+	}
+	switch ii.freshness() {
+	case stale:
+		p.Eat(nutrition * 0.75)
+	case spoiled:
+		p.Eat(nutrition * 0.5)
+		p.afflict(foodPoisoning)
+	default:
+		p.Eat(nutrition)
+	}
+}
+
+// FoodNote describes how fresh a stack of food is. ok is false for food that doesn't spoil.
+func (w *World) FoodNote(playerID, itemID string) (note i18n.Message, ok bool) {
+	e, ok := w.getPlayer(playerID)
+	if !ok {
+		return i18n.Message{}, false
+	}
+	ii, ok := e.player.inventoryMap[itemID]
+	if !ok || !ii.Item.Perishable() {
+		return i18n.Message{}, false
+	}
+	left := (1 - ii.age) * ii.Item.shelfLife
+	if left <= 0 {
+		return i18n.M("%s", i18n.Name(ii.freshness().String())), true
+	}
+	return i18n.M("%s, keeps about %.1f more days", i18n.Name(ii.freshness().String()), left), true
+}
+
+// dryWeatherCondition requires a dry day, for drying food in the open
+func dryWeatherCondition() condition {
+	return func(inventoryMap map[string]*InventoryItem, e *entity, w *World) (bool, map[string]int, []Requirement) {
+		met := w.weather < precipitation
+		req := Requirement{Description: i18n.M("dry weather"), Need: 1, Met: met}
+		if met {
+			req.Have = 1
+		}
+		return met, make(map[string]int), []Requirement{req}
+	}
+}
+
+var foodPoisoning = &effectKind{
+	name:      "Food poisoning",
+	color:     "#B5A642",
+	duration:  90 * time.Second,
+	stacking:  stackIntensify,
+	maxStacks: 3,
+	hunger:    1.5,
+	strength:  0.8,
+	interval:  15 * time.Second,
+	onTick:    damageOverTime("Your stomach heaves. You lost %d health", "Died of food poisoning"),
+	onset:     "That food was off. You feel sick",
+	wearsOff:  "Your stomach has settled",
+}
+
+var RawMeat = perishable(newItem(
+	"raw-meat",
+	"raw meat",
+	"m ",
+	"#C34B4B",
+	0.3,
+	0,
+	Edible,
+	false,
+	ActivateEdible(0.1, "You choke down the raw meat"),
+), 2)
+var RoastMeat = perishable(newItem(
+	"roast-meat",
+	"roast meat",
+	"m ",
+	"#8B4513",
+	0.25,
+	0,
+	Edible,
+	false,
+	ActivateEdible(0.3, "The roast meat is hot and filling", wellFed),
+), 3)
+var SmokedMeat = perishable(newItem(
+	"smoked-meat",
+	"smoked meat",
+	"m ",
+	"#5C3A21",
+	0.15,
+	0,
+	Edible,
+	false,
+	ActivateEdible(0.25, "The smoked meat is tough, but it fills you up"),
+), 60)
+var DriedCloudberries = perishable(newItem(
+	"dried-cloudberries",
+	"dried cloudberries",
+	". ",
+	"#C98A91",
+	0.02,
+	0,
+	Edible,
+	false,
+	ActivateEdible(0.25, "You chew a handful of dried cloudberries"),
+), 45)
+var BakedBirdCherries = perishable(newItem(
+	"baked-bird-cherries",
+	"baked bird cherries",
+	"፝፝",
+	"#3B2A2B",
+	0.1,
+	0,
+	Edible,
+	false,
+	ActivateEdible(0.2, "Baking took the bitterness out of the bird cherries"),
+), 10)
@@ -0,0 +1,85 @@
+package world
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFreshness(t *testing.T) {
+	tests := []struct {
+		age  float64
+		want freshness
+	}{
+		{0, fresh},
+		{staleAge - 0.01, fresh},
+		{staleAge, stale},
+		{0.99, stale},
+		{1, spoiled},
+		{3, spoiled},
+	}
+	for _, tt := range tests {
+		ii := InventoryItem{Item: Cloudberries, Quantity: 1, age: tt.age}
+		if got := ii.freshness(); got != tt.want {
+			t.Errorf("freshness() at age %v = %v, want %v", tt.age, got, tt.want)
+		}
+	}
+}
+
+func TestInventoryItemAdd(t *testing.T) {
+	tests := []struct {
+		name     string
+		item     *Item
+		quantity int
+		age      float64
+		addQ     int
+		addAge   float64
+		wantAge  float64
+	}{
+		{"averages by quantity", Cloudberries, 3, 0.8, 1, 0, 0.6},
+		{"into an empty stack", Cloudberries, 0, 0, 2, 0.5, 0.5},
+		{"doesn't age what keeps", Twine, 3, 0, 1, 0.5, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ii := &InventoryItem{Item: tt.item, Quantity: tt.quantity, age: tt.age}
+			ii.add(tt.addQ, tt.addAge)
+			if ii.Quantity != tt.quantity+tt.addQ {
+				t.Errorf("quantity = %d, want %d", ii.Quantity, tt.quantity+tt.addQ)
+			}
+			if d := ii.age - tt.wantAge; d > 1e-9 || d < -1e-9 {
+				t.Errorf("age = %v, want %v", ii.age, tt.wantAge)
+			}
+		})
+	}
+}
+
+func TestRecipeKeepsIngredientAge(t *testing.T) {
+	tests := []struct {
+		name       string
+		ingredient *Item
+		result     *Item
+		age        float64 // of the ingredient
+		want       float64 // days the result keeps
+	}{
+		{"fresh meat smoked", RawMeat, SmokedMeat, 0, 60},
+		{"old meat smoked", RawMeat, SmokedMeat, 0.9, 58.2},                      // 1.8 of its 2 days gone
+		{"half-fresh berries dried", Cloudberries, DriedCloudberries, 0.5, 43.5}, // 1.5 of 3 days gone
+		{"spoiled meat stays spoiled", RawMeat, SmokedMeat, 1.2, 0},
+		{"meat roasted", RawMeat, RoastMeat, 0.9, 1.2},
+		{"older than the result keeps", SmokedMeat, RoastMeat, 0.5, 0}, // 30 days gone, roast keeps 3
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newSimpleRecipe(tt.result, 0, InventoryItem{Item: tt.ingredient, Quantity: 1})
+			e := NewPlayer("p", Coord{})
+			inv := map[string]*InventoryItem{tt.ingredient.ID: {Item: tt.ingredient, Quantity: 1, age: tt.age}}
+			ok, inv := r.Do(inv, e, nil)
+			if !ok {
+				t.Fatal("Do() failed")
+			}
+			if got := (1 - inv[tt.result.ID].age) * tt.result.shelfLife; math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("result keeps %.2f days, want %.2f", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,5 +1,10 @@
 package world
 
+import (
+	"github.com/dustmason/nicefort/i18n"
+	"sort"
+)
+
 type ItemTraits int64
 
 const (
@@ -11,19 +16,41 @@ const (
 	Fuel
 	Edible
 	Stick
+	Clothing
 )
 
+var traitNames = map[ItemTraits]string{
+	Weapon:   "Weapon",
+	Digger:   "Digger",
+	Axe:      "Axe",
+	Knife:    "Knife",
+	Kindling: "Kindling",
+	Fuel:     "Fuel",
+	Edible:   "Edible",
+	Stick:    "Sticks",
+	Clothing: "Clothing",
+}
+
+func (t ItemTraits) String() string {
+	if n, ok := traitNames[t]; ok {
+		return n
+	}
+	return "Item"
+}
+
 type Item struct {
 	ID          string
 	Name        string
 	Description string
 	Weight      float64
 	loc         Coord
-	activate    func(*Item, *entity, *World) (bool, string) // accepts the player and world, returns true if the item is consumed, along with a message
+	activate    func(*Item, *entity, *World) (bool, i18n.Message) // accepts the player and world, returns true if the item is consumed, along with a message
 	icon        string
 	color       string
 	traits      ItemTraits
 	power       float64 // 0 < n < 1
+	warmth      float64 // °C, for clothing
+	shelfLife   float64 // days until perishable food spoils, or 0 if it keeps
 	nonPortable bool    // when it appears, immediately drop in closest avail location. can't pick up
 }
 
@@ -48,35 +75,125 @@ func (i Item) Power() float64 {
 	return i.power
 }
 
-func (i Item) Activate(e *entity, w *World) (bool, string) {
+func (i Item) Activate(e *entity, w *World) (bool, i18n.Message) {
 	if i.activate == nil {
-		return false, ""
+		return false, i18n.Message{}
 	}
 	return i.activate(&i, e, w)
 }
 
-func wieldable(i *Item, e *entity, w *World) (bool, string) {
+func wieldable(i *Item, e *entity, w *World) (bool, i18n.Message) {
 	if e.player.wielding != nil && e.player.wielding.ID == i.ID {
 		e.player.wielding = BareHands
-		return false, "You put away the " + i.Name
+		e.player.changed(StatsChanged)
+		return false, i18n.M("You put away the %s", i18n.Name(i.Name))
 	}
 	e.player.wielding = i
-	return false, "Now wielding: " + i.Name
+	e.player.changed(StatsChanged)
+	return false, i18n.M("Now wielding: %s", i18n.Name(i.Name))
+}
+
+// TraitNames lists the names of every trait the item has
+func (i Item) TraitNames() []string {
+	out := make([]string, 0)
+	for t := Weapon; t <= Clothing; t <<= 1 {
+		if i.HasTrait(t) {
+			out = append(out, t.String())
+		}
+	}
+	return out
+}
+
+// Category is a coarse grouping of items, used for sorting and display
+func (i Item) Category() string {
+	switch {
+	case i.HasTrait(Edible):
+		return "Food"
+	case i.traits&(Weapon|Digger|Axe|Knife) != 0:
+		return "Tool"
+	case i.traits&(Fuel|Kindling) != 0:
+		return "Fuel"
+	case i.HasTrait(Clothing):
+		return "Clothing"
+	default:
+		return "Material"
+	}
+}
+
+// Usable is true when activating the item from the inventory does something
+func (i Item) Usable() bool {
+	return i.activate != nil
 }
 
 type InventoryItem struct {
 	Item     *Item
 	Quantity int
+	age      float64 // how much of a perishable item's shelf life is used up, where 1 is spoiled
 }
 
 func (ii InventoryItem) Weight() float64 {
 	return float64(ii.Quantity) * ii.Item.Weight
 }
 
-func ActivateEdible(nutrition float64, message string) func(*Item, *entity, *World) (bool, string) {
-	return func(i *Item, e *entity, w *World) (bool, string) {
-		e.player.Eat(nutrition)
-		return true, message
+type InventorySort int
+
+const (
+	SortByName InventorySort = iota
+	SortByWeight
+	SortByQuantity
+	SortByCategory
+)
+
+var inventorySortNames = []string{"name", "weight", "quantity", "category"}
+
+func (s InventorySort) String() string {
+	return inventorySortNames[s]
+}
+
+// Next cycles through the available sort orders
+func (s InventorySort) Next() InventorySort {
+	return (s + 1) % InventorySort(len(inventorySortNames))
+}
+
+// SortInventory returns a sorted copy of ii. ties are broken by name and then ID so the order is stable.
+func SortInventory(ii []*InventoryItem, by InventorySort) []*InventoryItem {
+	out := make([]*InventoryItem, len(ii))
+	copy(out, ii)
+	byName := func(a, b *InventoryItem) bool {
+		if a.Item.Name != b.Item.Name {
+			return a.Item.Name < b.Item.Name
+		}
+		return a.Item.ID < b.Item.ID
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		a, b := out[i], out[j]
+		switch by {
+		case SortByWeight:
+			if a.Weight() != b.Weight() {
+				return a.Weight() > b.Weight()
+			}
+		case SortByQuantity:
+			if a.Quantity != b.Quantity {
+				return a.Quantity > b.Quantity
+			}
+		case SortByCategory:
+			if a.Item.Category() != b.Item.Category() {
+				return a.Item.Category() < b.Item.Category()
+			}
+		}
+		return byName(a, b)
+	})
+	return out
+}
+
+// ActivateEdible feeds the player, and gives them any effects the food has
+func ActivateEdible(nutrition float64, message string, effects ...*effectKind) func(*Item, *entity, *World) (bool, i18n.Message) {
+	return func(i *Item, e *entity, w *World) (bool, i18n.Message) {
+		e.player.eat(i, nutrition)
+		for _, k := range effects {
+			e.player.afflict(k)
+		}
+		return true, i18n.M(message)
 	}
 }
 
@@ -85,7 +202,7 @@ func newItem(
 	weight, power float64,
 	traits ItemTraits,
 	nonPortable bool,
-	activate func(*Item, *entity, *World) (bool, string),
+	activate func(*Item, *entity, *World) (bool, i18n.Message),
 ) *Item {
 	return &Item{
 		ID:          id,
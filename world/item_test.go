@@ -0,0 +1,51 @@
+package world
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSortInventory(t *testing.T) {
+	stack := func(id, name string, weight float64, traits ItemTraits, quantity int) *InventoryItem {
+		return &InventoryItem{Item: newItem(id, name, "x ", "#FFFFFF", weight, 0, traits, false, nil), Quantity: quantity}
+	}
+	inventory := []*InventoryItem{
+		stack("rope", "rope", 1, 0, 2),              // 2kg, material
+		stack("axe", "axe", 2, Axe, 1),              // 2kg, tool
+		stack("berries", "berries", 0.5, Edible, 4), // 2kg, food
+		stack("stone-b", "stone", 1, 0, 1),          // same name as stone-a
+		stack("stone-a", "stone", 1, 0, 1),
+		stack("bark", "bark", 0.1, Fuel, 10), // 1kg, fuel
+	}
+	tests := []struct {
+		by   InventorySort
+		want string
+	}{
+		{SortByName, "axe bark berries rope stone-a stone-b"},
+		{SortByWeight, "axe berries rope bark stone-a stone-b"},
+		{SortByQuantity, "bark berries rope axe stone-a stone-b"},
+		{SortByCategory, "berries bark rope stone-a stone-b axe"},
+	}
+	for _, tt := range tests {
+		for _, in := range [][]*InventoryItem{inventory, reversed(inventory)} {
+			ids := make([]string, 0, len(in))
+			for _, ii := range SortInventory(in, tt.by) {
+				ids = append(ids, ii.Item.ID)
+			}
+			if got := strings.Join(ids, " "); got != tt.want {
+				t.Errorf("SortInventory(by %s) = %s, want %s", tt.by, got, tt.want)
+			}
+		}
+	}
+	if inventory[0].Item.ID != "rope" {
+		t.Errorf("SortInventory reordered its argument")
+	}
+}
+
+func reversed(ii []*InventoryItem) []*InventoryItem {
+	out := make([]*InventoryItem, len(ii))
+	for i, x := range ii {
+		out[len(ii)-1-i] = x
+	}
+	return out
+}
@@ -0,0 +1,117 @@
+package world
+
+import (
+	"github.com/dustmason/nicefort/events"
+	"github.com/dustmason/nicefort/i18n"
+	"strings"
+	"time"
+)
+
+const mailboxSize = 50 // letters a player can hold before their mailbox is full
+
+// Letter is a message left for a player with /mail, read whenever they next play
+type Letter struct {
+	ID   int
+	From string
+	Text string
+	Sent time.Time
+	Read bool
+}
+
+// sendMail handles "/mail <name> <message>". the recipient only has to be known to the world, not online.
+func (w *World) sendMail(e *entity, args string) {
+	name, message, _ := strings.Cut(args, " ")
+	message = strings.TrimSpace(message)
+	if name == "" || message == "" {
+		e.player.Event(events.Warning, events.Chat, i18n.M("Usage: %s", chatCommands["mail"].usage))
+		return
+	}
+	target, err := w.playerNamed(name)
+	if err != nil {
+		notFound(e, name, err, "Nobody is called %s")
+		return
+	}
+	message = w.filterChat(message)
+	w.Lock() // the mailbox is read from the recipient's session
+	full := len(target.player.mail) >= mailboxSize
+	if !full {
+		target.player.nextLetterID++
+		target.player.mail = append(target.player.mail, Letter{
+			ID:   target.player.nextLetterID,
+			From: e.player.name,
+			Text: message,
+			Sent: time.Now(),
+		})
+	}
+	w.Unlock()
+	if full {
+		e.player.Event(events.Warning, events.Chat, i18n.M("%s's mailbox is full", target.player.name))
+		return
+	}
+	e.player.Event(events.Info, events.Chat, i18n.M("You sent a letter to %s", target.player.name))
+	if !target.player.mutes(e.player.name) {
+		target.player.Event(events.Info, events.Chat, i18n.M("You have a letter from %s. Press M to read it", e.player.name))
+	}
+	target.player.changed(StatsChanged)
+}
+
+// Mail lists the player's letters, newest first
+func (w *World) Mail(playerID string) []Letter {
+	w.RLock()
+	defer w.RUnlock()
+	e, ok := w.players[playerID]
+	if !ok {
+		return nil
+	}
+	out := make([]Letter, len(e.player.mail))
+	for i, l := range e.player.mail {
+		out[len(out)-1-i] = l
+	}
+	return out
+}
+
+// ReadMail marks a letter as read
+func (w *World) ReadMail(playerID string, letterID int) {
+	w.updateMail(playerID, func(p *player) {
+		for i := range p.mail {
+			if p.mail[i].ID == letterID {
+				p.mail[i].Read = true
+			}
+		}
+	})
+}
+
+// DeleteMail throws a letter away
+func (w *World) DeleteMail(playerID string, letterID int) {
+	w.updateMail(playerID, func(p *player) {
+		for i := range p.mail {
+			if p.mail[i].ID == letterID {
+				p.mail = append(p.mail[:i], p.mail[i+1:]...)
+				return
+			}
+		}
+	})
+}
+
+func (w *World) updateMail(playerID string, f func(p *player)) {
+	w.Lock()
+	e, ok := w.players[playerID]
+	if ok {
+		f(e.player)
+	}
+	w.Unlock()
+	if ok {
+		e.player.changed(StatsChanged)
+	}
+}
+
+// unreadMail counts the letters the player hasn't read. the caller must hold the world lock.
+func (p *player) unreadMail() int {
+	n := 0
+	for _, l := range p.mail {
+		if !l.Read {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,226 @@
+package world
+
+import (
+	"errors"
+	"github.com/dustmason/nicefort/events"
+	"github.com/dustmason/nicefort/i18n"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const chatBurst = 5                 // chat messages a player may send within chatWindow
+const chatWindow = 10 * time.Second // the period chatBurst applies to
+
+var noPlayerError = errors.New("no player by that name")
+var ambiguousNameError = errors.New("more than one player by that name")
+
+// SetChatFilter stars out the given words wherever they appear in chat, ignoring case
+func (w *World) SetChatFilter(words []string) {
+	quoted := make([]string, 0, len(words))
+	for _, word := range words {
+		if word = strings.TrimSpace(word); word != "" {
+			quoted = append(quoted, regexp.QuoteMeta(word))
+		}
+	}
+	w.Lock()
+	defer w.Unlock()
+	w.chatFilter = nil
+	if len(quoted) > 0 {
+		w.chatFilter = regexp.MustCompile(`(?i)\b(` + strings.Join(quoted, "|") + `)\b`)
+	}
+}
+
+func (w *World) filterChat(message string) string {
+	w.RLock()
+	defer w.RUnlock()
+	if w.chatFilter == nil {
+		return message
+	}
+	return w.chatFilter.ReplaceAllStringFunc(message, func(word string) string {
+		return strings.Repeat("*", utf8.RuneCountInString(word))
+	})
+}
+
+// allowChat checks that the player isn't silenced or sending too quickly, telling them if they are
+func (w *World) allowChat(e *entity) bool {
+	now := time.Now()
+	w.Lock()
+	silenced := e.player.silencedUntil.Sub(now)
+	recent := e.player.recentChat[:0]
+	for _, t := range e.player.recentChat {
+		if now.Sub(t) < chatWindow {
+			recent = append(recent, t)
+		}
+	}
+	limited := silenced <= 0 && len(recent) >= chatBurst
+	if silenced <= 0 && !limited {
+		recent = append(recent, now)
+	}
+	e.player.recentChat = recent
+	w.Unlock()
+	switch {
+	case silenced > 0:
+		e.player.Event(events.Warning, events.Chat, i18n.M("A moderator has muted you for another %s", formatDuration(silenced)))
+		return false
+	case limited:
+		e.player.Event(events.Warning, events.Chat, i18n.M("You're sending messages too quickly. Wait a moment"))
+		return false
+	}
+	return true
+}
+
+// Silence stops the named player from chatting for d, or lets them chat again if d is 0. moderatorID
+// is the player doing it. it fails if nobody, or more than one player, has the name.
+func (w *World) Silence(moderatorID, name string, d time.Duration) error {
+	e, err := w.playerNamed(name)
+	if err != nil {
+		return err
+	}
+	until := time.Now().Add(d)
+	w.Lock()
+	e.player.silencedUntil = until
+	w.Unlock()
+	if d > 0 {
+		e.player.Event(events.Warning, events.Chat, i18n.M("A moderator has muted you for %s", formatDuration(d)))
+	} else {
+		e.player.Event(events.Info, events.Chat, i18n.M("A moderator has unmuted you"))
+	}
+	w.publishModeration(moderatorID, e, "silence", &until)
+	return nil
+}
+
+// Kick closes the named player's session. they can reconnect straight away unless they're banned too.
+func (w *World) Kick(moderatorID, name string) error {
+	e, err := w.onlinePlayerNamed(name)
+	if err != nil {
+		return err
+	}
+	w.Event(events.Warning, i18n.M("%s was kicked", e.player.name))
+	w.publishModeration(moderatorID, e, "kick", nil)
+	if e.player.onKick != nil {
+		e.player.onKick()
+	}
+	return nil
+}
+
+// Ban stops the named player's key from connecting for d, kicking them if they're online. a d of 0
+// lifts the ban.
+func (w *World) Ban(moderatorID, name string, d time.Duration) error {
+	e, err := w.playerNamed(name)
+	if err != nil {
+		return err
+	}
+	until := time.Now().Add(d)
+	w.Lock()
+	if d > 0 {
+		w.bans[normalizeKey(e.player.id)] = until
+	} else {
+		delete(w.bans, normalizeKey(e.player.id))
+	}
+	w.Unlock()
+	w.publishModeration(moderatorID, e, "ban", &until)
+	if d <= 0 {
+		return nil
+	}
+	w.Event(events.Warning, i18n.M("%s was banned for %s", e.player.name, formatDuration(d)))
+	if w.online(e.player.id) && e.player.onKick != nil {
+		e.player.onKick()
+	}
+	return nil
+}
+
+// Banned reports whether the player's key is banned, and until when
+func (w *World) Banned(playerID string) (time.Time, bool) {
+	k := normalizeKey(playerID)
+	w.Lock()
+	defer w.Unlock()
+	until, ok := w.bans[k]
+	if ok && time.Now().After(until) {
+		delete(w.bans, k)
+		return time.Time{}, false
+	}
+	return until, ok
+}
+
+// ClearChat removes all chat from the world feed and from what every player has heard nearby
+func (w *World) ClearChat(moderatorID string) {
+	w.events.RemoveTopic(events.Chat)
+	w.RLock()
+	for _, e := range w.players {
+		e.player.chat.RemoveTopic(events.Chat)
+	}
+	w.RUnlock()
+	w.Event(events.Info, i18n.M("A moderator cleared the chat"))
+	w.publishModeration(moderatorID, nil, "clear", nil)
+}
+
+func (w *World) publishModeration(moderatorID string, target *entity, action string, until *time.Time) {
+	m := events.Moderated{Action: action, Until: until}
+	if mod, ok := w.getPlayer(moderatorID); ok {
+		m.Moderator = mod.player.name
+	}
+	x, y := 0, 0
+	if target != nil {
+		m.Target = target.player.name
+		x, y = target.player.GetLocation()
+	}
+	w.publish(moderatorID, x, y, m)
+}
+
+// playerNamed finds any player the world knows about by name, online or not, ignoring case
+func (w *World) playerNamed(name string) (*entity, error) {
+	w.RLock()
+	defer w.RUnlock()
+	players := make([]*entity, 0, len(w.players))
+	for _, e := range w.players {
+		players = append(players, e)
+	}
+	return onlyNamed(players, name)
+}
+
+// onlyNamed picks the player called name out of players. names come from the ssh user and aren't
+// unique, so a name that more than one player has finds nobody rather than the wrong one.
+func onlyNamed(players []*entity, name string) (*entity, error) {
+	var found *entity
+	for _, e := range players {
+		if !strings.EqualFold(e.player.name, name) {
+			continue
+		}
+		if found != nil {
+			return nil, ambiguousNameError
+		}
+		found = e
+	}
+	if found == nil {
+		return nil, noPlayerError
+	}
+	return found, nil
+}
+
+// parseDuration is time.ParseDuration that also understands days, eg "2d"
+func parseDuration(s string) (time.Duration, bool) {
+	if strings.HasSuffix(s, "d") {
+		n, err := strconv.Atoi(strings.TrimSuffix(s, "d"))
+		return time.Duration(n) * 24 * time.Hour, err == nil && n >= 0
+	}
+	d, err := time.ParseDuration(s)
+	return d, err == nil && d >= 0
+}
+
+// formatDuration shows a duration to the minute, eg "1h30m", or in days if it's a whole number of them
+func formatDuration(d time.Duration) string {
+	if d < time.Minute {
+		return "1m"
+	}
+	if day := 24 * time.Hour; d%day == 0 {
+		return strconv.Itoa(int(d/day)) + "d"
+	}
+	s := strings.TrimSuffix(d.Round(time.Minute).String(), "0s")
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+	return s
+}
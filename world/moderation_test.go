@@ -0,0 +1,81 @@
+package world
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Duration
+		ok   bool
+	}{
+		{"10m", 10 * time.Minute, true},
+		{"1h30m", 90 * time.Minute, true},
+		{"2d", 48 * time.Hour, true},
+		{"0", 0, true},
+		{"0d", 0, true},
+		{"-5m", 0, false},
+		{"-1d", 0, false},
+		{"d", 0, false},
+		{"1.5d", 0, false},
+		{"soon", 0, false},
+		{"", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := parseDuration(tt.in)
+		if ok != tt.ok || (ok && got != tt.want) {
+			t.Errorf("parseDuration(%q) = %v, %v, want %v, %v", tt.in, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		in   time.Duration
+		want string
+	}{
+		{0, "1m"},
+		{30 * time.Second, "1m"},
+		{time.Minute, "1m"},
+		{90 * time.Second, "2m"},
+		{45 * time.Minute, "45m"},
+		{time.Hour, "1h"},
+		{90 * time.Minute, "1h30m"},
+		{24 * time.Hour, "1d"},
+		{72 * time.Hour, "3d"},
+		{25 * time.Hour, "25h"},
+	}
+	for _, tt := range tests {
+		if got := formatDuration(tt.in); got != tt.want {
+			t.Errorf("formatDuration(%v) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestOnlyNamed(t *testing.T) {
+	named := func(name string) *entity {
+		return &entity{player: &player{name: name}}
+	}
+	pat, sam, other := named("pat"), named("Sam"), named("sam")
+	tests := []struct {
+		name    string
+		players []*entity
+		want    *entity
+		err     error
+	}{
+		{"pat", []*entity{pat, sam}, pat, nil},
+		{"PAT", []*entity{pat, sam}, pat, nil},
+		{"sam", []*entity{pat, sam}, sam, nil},
+		{"sam", []*entity{pat, sam, other}, nil, ambiguousNameError},
+		{"kim", []*entity{pat, sam}, nil, noPlayerError},
+		{"pat", nil, nil, noPlayerError},
+	}
+	for _, tt := range tests {
+		got, err := onlyNamed(tt.players, tt.name)
+		if got != tt.want || err != tt.err {
+			t.Errorf("onlyNamed(%d players, %q) = %v, %v, want %v, %v", len(tt.players), tt.name, got, err, tt.want, tt.err)
+		}
+	}
+}
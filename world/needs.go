@@ -0,0 +1,165 @@
+package world
+
+import (
+	"github.com/charmbracelet/bubbles/progress"
+	"github.com/dustmason/nicefort/events"
+	"github.com/dustmason/nicefort/i18n"
+	"time"
+)
+
+const needDamageInterval = 30 * time.Second // how often a critical need takes health
+const needDamage = 1                        // health lost each needDamageInterval
+
+// needLevel is how badly a player needs something, eg food or water. each level makes them slower
+// and weaker.
+type needLevel int
+
+const (
+	needNone needLevel = iota
+	needMild
+	needSevere
+	needCritical
+)
+
+var needThresholds = []float64{0, 0.5, 0.75, 1.0} // value at which each level begins
+
+func levelOf(value float64) needLevel {
+	l := needNone
+	for i, t := range needThresholds {
+		if value >= t {
+			l = needLevel(i)
+		}
+	}
+	return l
+}
+
+// slowdown multiplies the time a player has to wait between moves
+func (l needLevel) slowdown() float64 {
+	switch l {
+	case needSevere:
+		return 1.5
+	case needCritical:
+		return 2
+	}
+	return 1
+}
+
+// strength scales how much progress a player makes with each blow when harvesting
+func (l needLevel) strength() float64 {
+	switch l {
+	case needSevere:
+		return 0.75
+	case needCritical:
+		return 0.5
+	}
+	return 1
+}
+
+func (l needLevel) color() string {
+	switch l {
+	case needSevere:
+		return "#FDC300"
+	case needCritical:
+		return "#FF5F5F"
+	}
+	return "#7BC96F"
+}
+
+// need describes one of a player's needs, and what they're told as it gets worse
+type need struct {
+	meter    string   // sidebar label, eg "Hunger: %s"
+	labels   []string // for each level
+	warnings []string // shown when the player reaches each level
+	damage   string   // shown when a critical need takes health, "" if it never does
+	cause    string   // of death, when the need kills
+}
+
+var hungerNeed = need{
+	meter:  "Hunger: %s",
+	labels: []string{"Fed", "Peckish", "Hungry", "Starving"},
+	warnings: []string{
+		"",
+		"You're getting peckish",
+		"You're hungry. You move and work more slowly",
+		"You're starving! Eat something before it kills you",
+	},
+	damage: "You are starving. You lost %d health",
+	cause:  "Starved to death",
+}
+
+var thirstNeed = need{
+	meter:  "Thirst: %s",
+	labels: []string{"Quenched", "Thirsty", "Parched", "Dehydrated"},
+	warnings: []string{
+		"",
+		"You're thirsty",
+		"You're parched. You move and work more slowly",
+		"You're dehydrated! Find water before it kills you",
+	},
+	damage: "You are dehydrated. You lost %d health",
+	cause:  "Died of thirst",
+}
+
+var coldNeed = need{
+	meter:  "Warmth: %s",
+	labels: []string{"Warm", "Cold", "Hypothermic", "Freezing"},
+	warnings: []string{
+		"",
+		"You're getting cold",
+		"You're hypothermic. You move and work more slowly",
+		"You're freezing to death! Get to a fire or shelter",
+	},
+	damage: "You are freezing. You lost %d health",
+	cause:  "Froze to death",
+}
+
+// suffer checks the level of a need, warning the player when it gets worse, and takes their health
+// while it's critical. hurt is when it last did, or when the need was last not critical. it may be
+// nil for needs that don't do damage.
+func (p *player) suffer(w *World, t time.Time, n need, value float64, level *needLevel, hurt *time.Time) {
+	l := levelOf(value)
+	if l > *level && n.warnings[l] != "" {
+		kind := events.Warning
+		if l == needCritical {
+			kind = events.Danger
+		}
+		p.Event(kind, events.System, i18n.M(n.warnings[l]))
+	}
+	if l != *level {
+		*level = l
+		p.changed(StatsChanged)
+	}
+	if n.damage == "" {
+		return
+	}
+	if l < needCritical {
+		*hurt = t
+		return
+	}
+	if t.Sub(*hurt) >= needDamageInterval {
+		*hurt = t
+		p.Attacked(w, needDamage, i18n.M(n.damage, needDamage), i18n.M(n.cause))
+	}
+}
+
+// worstNeed is the level of whichever need is most pressing, which decides the player's penalties
+func (p *player) worstNeed() needLevel {
+	worst := p.hungerLevel
+	for _, l := range []needLevel{p.thirstLevel, p.coldLevel, p.fatigueLevel} {
+		if l > worst {
+			worst = l
+		}
+	}
+	return worst
+}
+
+// renderNeed is a sidebar meter: a label and a bar that fills up as the need gets worse
+func renderNeed(l i18n.Locale, n need, value float64, level needLevel) string {
+	bar := progress.New(progress.WithSolidFill(level.color()), progress.WithoutPercentage())
+	bar.Width = 19
+	fill := value / needThresholds[needCritical]
+	if fill > 1 {
+		fill = 1
+	}
+	return l.T(n.meter, i18n.Name(n.labels[level])) + "\n" + bar.ViewAs(fill)
+}
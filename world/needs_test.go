@@ -0,0 +1,25 @@
+package world
+
+import "testing"
+
+func TestLevelOf(t *testing.T) {
+	tests := []struct {
+		value float64
+		want  needLevel
+	}{
+		{-0.1, needNone},
+		{0, needNone},
+		{0.49, needNone},
+		{0.5, needMild},
+		{0.74, needMild},
+		{0.75, needSevere},
+		{0.99, needSevere},
+		{1, needCritical},
+		{1.5, needCritical},
+	}
+	for _, tt := range tests {
+		if got := levelOf(tt.value); got != tt.want {
+			t.Errorf("levelOf(%v) = %d, want %d", tt.value, got, tt.want)
+		}
+	}
+}
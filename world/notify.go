@@ -0,0 +1,149 @@
+package world
+
+import (
+	"sync"
+)
+
+// Change is a bitmask describing what changed for a player since their last Notification
+type Change int
+
+const (
+	MapChanged    Change = 1 << iota // a tile near the player changed
+	StatsChanged                     // something shown in the sidebar or status bar changed
+	EventsChanged                    // the player's own event feed has a new entry
+	FeedChanged                      // the world feed (chat, joins, deaths) has a new entry
+	Died                             // the player died, and their session should show the death screen
+)
+
+// Notification is delivered to a session whenever something it displays has changed.
+// Notifications are coalesced, so a single one may describe several changes.
+type Notification struct {
+	Changes Change
+	Feed    string // the rendered world feed, set when Changes includes FeedChanged
+}
+
+// subscriber coalesces notifications for one session and delivers them from its own goroutine,
+// so the world never blocks on a slow (or busy) session while holding its lock.
+type subscriber struct {
+	sync.Mutex
+	pending Notification
+	signal  chan struct{}
+	done    chan struct{}
+	view    feedView // how the session's player sees the feed. guarded by the world's subsLock.
+}
+
+func newSubscriber(deliver func(Notification), view feedView) *subscriber {
+	s := &subscriber{
+		signal: make(chan struct{}, 1),
+		done:   make(chan struct{}),
+		view:   view,
+	}
+	go s.run(deliver)
+	return s
+}
+
+func (s *subscriber) notify(n Notification) {
+	s.Lock()
+	s.pending.Changes |= n.Changes
+	if n.Changes&FeedChanged != 0 {
+		s.pending.Feed = n.Feed
+	}
+	s.Unlock()
+	select {
+	case s.signal <- struct{}{}:
+	default: // a delivery is already pending, and it will pick up these changes
+	}
+}
+
+func (s *subscriber) run(deliver func(Notification)) {
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-s.signal:
+			s.Lock()
+			n := s.pending
+			s.pending = Notification{}
+			s.Unlock()
+			deliver(n)
+		}
+	}
+}
+
+func (s *subscriber) close() {
+	close(s.done)
+}
+
+// Subscribe registers a session to be told about changes relevant to the player. deliver is called
+// from a separate goroutine, typically to hand the Notification to a tea.Program with Send.
+func (w *World) Subscribe(playerID string, deliver func(Notification)) {
+	v := w.feedViewOf(playerID) // before subsLock, which is always taken after the world lock
+	w.subsLock.Lock()
+	defer w.subsLock.Unlock()
+	if s, ok := w.subscribers[playerID]; ok {
+		s.close()
+	}
+	s := newSubscriber(deliver, v)
+	w.subscribers[playerID] = s
+	s.notify(Notification{Changes: MapChanged | StatsChanged | FeedChanged, Feed: w.renderFeed(v)})
+}
+
+// Unsubscribe stops delivering notifications to the player's session
+func (w *World) Unsubscribe(playerID string) {
+	w.subsLock.Lock()
+	defer w.subsLock.Unlock()
+	if s, ok := w.subscribers[playerID]; ok {
+		s.close()
+		delete(w.subscribers, playerID)
+	}
+}
+
+// notify tells a single player's session that something changed. subscribers have their own lock,
+// so this is safe to call whether or not the world lock is held.
+func (w *World) notify(playerID string, c Change) {
+	w.subsLock.RLock()
+	defer w.subsLock.RUnlock()
+	if s, ok := w.subscribers[playerID]; ok {
+		s.notify(Notification{Changes: c})
+	}
+}
+
+// notifyFeed re-renders the feed for a single player's session
+func (w *World) notifyFeed(playerID string) {
+	w.subsLock.RLock()
+	defer w.subsLock.RUnlock()
+	if s, ok := w.subscribers[playerID]; ok {
+		s.notify(Notification{Changes: FeedChanged, Feed: w.renderFeed(s.view)})
+	}
+}
+
+// refreshFeed gives the player's session a new feed view, after they change how they see the feed,
+// and re-renders it
+func (w *World) refreshFeed(playerID string, v feedView, c Change) {
+	w.subsLock.Lock()
+	defer w.subsLock.Unlock()
+	if s, ok := w.subscribers[playerID]; ok {
+		s.view = v
+		s.notify(Notification{Changes: c | FeedChanged, Feed: w.renderFeed(v)})
+	}
+}
+
+// notifyAll tells every session that something changed
+func (w *World) notifyAll(c Change) {
+	w.subsLock.RLock()
+	defer w.subsLock.RUnlock()
+	for _, s := range w.subscribers {
+		s.notify(Notification{Changes: c})
+	}
+}
+
+// notifyNearby tells every player who could be looking at the tile at index i that it changed
+func (w *World) notifyNearby(i int) {
+	x, y := w.coordinates(i)
+	c := Coord{x, y}
+	for id, e := range w.players {
+		if e.player.loc.Distance(c) <= viewRadius+1 {
+			w.notify(id, MapChanged)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package world
 import (
 	"fmt"
 	"github.com/japanoise/dmap"
+	"math"
 	"math/rand"
 	"sync"
 	"time"
@@ -16,6 +17,7 @@ type NPC struct {
 	speed              float64 // 1.0 == every tick
 	baseSpeed          float64 // 1.0 == every tick
 	sense              float64 // 1.0 == wakes up as soon as player sees it
+	aggressive         bool    // fights back (and is described as hostile)
 	health             int
 	maxHealth          int
 	mood               mood
@@ -29,6 +31,7 @@ type NPC struct {
 	lastCalculatedPath time.Time
 	dead               bool
 	mapView            *mapView
+	effects            effects
 }
 
 type behavior func(w *World, e *entity) // todo a function that determines what this npc does next
@@ -54,7 +57,8 @@ const (
 )
 
 func (n *NPC) Tick(now time.Time, w *World, e *entity) {
-	if now.Sub(n.lastMoved).Seconds() > (1 - n.speed) {
+	n.effects.tick(w, n, now)
+	if now.Sub(n.lastMoved).Seconds() > (1-n.speed)*n.effects.slowdown() {
 		n.Lock()
 		defer n.Unlock()
 		if !n.dead {
@@ -87,17 +91,19 @@ func (n *NPC) Attackable() bool {
 	return true
 }
 
-// Attacked returns the amount of damage done, whether the npc is alive, and any items dropped
-func (n *NPC) Attacked(by *Item, e *entity, damage int) (int, bool, bool, []*InventoryItem) {
+// Attacked scales the damage done by the item with strength. It returns the amount of damage done,
+// whether the item can hurt the npc at all, whether the npc died, and any items dropped
+func (n *NPC) Attacked(by *Item, e *entity, strength float64) (int, bool, bool, []*InventoryItem) {
 	n.mood = terrorized
 	success, amount := n.damagedBy(by)
+	amount = int(math.Round(float64(amount) * strength))
 	n.health -= amount
 	n.targets[e] = enemy
 	if n.health <= 0 {
 		n.dead = true
-		return damage, true, true, n.drop
+		return amount, true, true, n.drop
 	}
-	return damage, success, false, nil
+	return amount, success, false, nil
 }
 
 func newNPC(name, icon string, speed float64, health int, damageRange [2]int, b behavior, x, y int) *NPC {
@@ -243,11 +249,16 @@ func targetsToPoints(targets map[*entity]targetWeight) []dmap.Point {
 // perch are also found.
 
 func NewRabbit(x, y int) *NPC {
-	return newNPC("rabbit", "r", 0.2, 30, [2]int{0, 1}, defenselessCreature, x, y)
+	n := newNPC("rabbit", "r", 0.2, 30, [2]int{0, 1}, defenselessCreature, x, y)
+	n.drop = []*InventoryItem{{Item: RawMeat, Quantity: 2}}
+	return n
 }
 
 func NewBrownBear(x, y int) *NPC {
-	return newNPC("brown bear", "b", 0.5, 300, [2]int{10, 100}, aggressiveCreature, x, y)
+	n := newNPC("brown bear", "b", 0.5, 300, [2]int{10, 100}, aggressiveCreature, x, y)
+	n.aggressive = true
+	n.drop = []*InventoryItem{{Item: RawMeat, Quantity: 12}}
+	return n
 }
 
 // func NewDeer(x, y int) *NPC {
@@ -1,16 +1,23 @@
 package world
 
 import (
-	"fmt"
 	"github.com/charmbracelet/bubbles/progress"
 	"github.com/dustmason/nicefort/events"
 	"github.com/dustmason/nicefort/fov"
+	"github.com/dustmason/nicefort/i18n"
 	"math"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 )
 
-const hungerRate = 1.0 / 60 / 60 // how much of total hunger to experience per second. 1/60/60 == must eat once per hour
+const hungerRate = 1.0 / 60 / 60  // how much of total hunger to experience per second. 1/60/60 == must eat once per hour
+const thirstRate = 2 * hungerRate // must drink every half hour
+const summerThirst = 1.5          // thirst rises this much faster in summer
+const playerFeedLength = 4        // number of events shown in the feed above the map
+const playerEventHistory = 500    // number of events kept for the message log
+const viewRadius = 10             // how far a player can see
 
 type Activity struct {
 	description string
@@ -25,24 +32,54 @@ type player struct {
 	loc             Coord
 	mapMem          map[Coord]string // map of what player knows on current world
 	view            *fov.View
+	viewVersion     int                       // incremented every time the view is recomputed
 	inventoryMap    map[string]*InventoryItem // map of item id => inventoryItem
 	inventory       []*InventoryItem
 	moveSpeed       float64 // 0 < n < 1.0
 	events          *events.EventList
+	chat            *events.EventList // local chat this player has heard, shown alongside the world feed
+	timestamps      events.TimestampMode
+	locale          i18n.Locale
+	muted           *muteList   // players whose chat is hidden from this player
+	silencedUntil   time.Time   // a moderator has stopped this player from chatting until then
+	recentChat      []time.Time // when this player's recent chat messages were sent, for rate limiting
+	mail            []Letter    // letters left for this player, oldest first
+	nextLetterID    int
 	wielding        *Item
 	currentActivity Activity
 	dead            bool
-	onDeath         func()
+	cause           i18n.Message // what killed the player
+	death           Death        // set when they die, for the death screen
+	born            float64      // w.days when this life began
+	life            lifeStats
+	effects         effects
+	xp              [numSkills]float64
+	known           map[string]bool // herbs the player has identified
+	onKick          func()
+	notify          func(Change) // tells the player's session that something it displays changed
 
 	// counters
-	carrying  float64
-	maxCarry  float64
-	health    int
-	maxHealth int
-	hunger    float64 // 0 < n < 1
-	money     int
-	lastMoved time.Time // for applying moveSpeed
-	lastTick  time.Time
+	carrying     float64
+	maxCarry     float64
+	health       int
+	maxHealth    int
+	hunger       float64   // 0 < n, starving from 1
+	hungerLevel  needLevel // as of the last tick
+	lastStarved  time.Time // when starvation last took health, or when the player was last not starving
+	thirst       float64   // 0 < n, dehydrated from 1
+	thirstLevel  needLevel
+	lastParched  time.Time
+	bodyTemp     float64 // °C
+	coldLevel    needLevel
+	lastFroze    time.Time
+	wearing      map[string]*Item // item id => clothing the player has on
+	fatigue      float64          // 0 < n, exhausted from 1
+	fatigueLevel needLevel
+	rest         rest
+	regen        float64 // health regained since the last whole point
+	money        int
+	lastMoved    time.Time // for applying moveSpeed
+	lastTick     time.Time
 }
 
 func (p *player) String() string {
@@ -75,30 +112,82 @@ func NewPlayer(id string, c Coord) *entity {
 		money:        0,
 		moveSpeed:    0.2,
 		hunger:       0.,
-		events:       events.NewEventList(4),
+		events:       events.NewEventList(playerEventHistory),
+		chat:         events.NewEventList(playerEventHistory),
+		locale:       i18n.English,
+		muted:        newMuteList(),
 		lastTick:     time.Now(),
 		wielding:     BareHands,
+		bodyTemp:     normalBodyTemp,
+		wearing:      make(map[string]*Item),
+		known:        make(map[string]bool),
 	}
 
 	return &entity{player: p}
 }
 
-func (p *player) Tick(t time.Time) {
+// Tick runs the player's needs and effects. it's called by the world ticker without the world lock,
+// so anything it needs from the map is passed in.
+func (p *player) Tick(w *World, t time.Time, s surroundings) {
 	elapsed := t.Sub(p.lastTick).Seconds()
-	p.hunger += elapsed * hungerRate
-	// todo starve if too hungry
+	before := math.Round(p.hunger*100) + math.Round(p.thirst*100)
+	p.tickEffects(w, t)
+	p.spoil(w, elapsed)
+	p.hunger += elapsed * hungerRate * p.effects.hunger()
+	rate := thirstRate
+	if season, _ := w.season(); seasons[season] == "Summer" {
+		rate *= summerThirst
+	}
+	p.thirst += elapsed * rate
+	if math.Round(p.hunger*100)+math.Round(p.thirst*100) != before { // the need bars move in steps of about 1/19
+		p.changed(StatsChanged)
+	}
 	p.lastTick = t
+	p.suffer(w, t, hungerNeed, p.hunger, &p.hungerLevel, &p.lastStarved)
+	p.suffer(w, t, thirstNeed, p.thirst, &p.thirstLevel, &p.lastParched)
+	p.warm(w, elapsed, s)
+	p.suffer(w, t, coldNeed, p.cold(), &p.coldLevel, &p.lastFroze)
+	p.recover(elapsed, s)
+	p.suffer(w, t, fatigueNeed, p.fatigue, &p.fatigueLevel, nil)
+	if p.fatigueLevel == needCritical {
+		p.afflict(exhausted)
+	}
+}
+
+// changed notifies the player's session, if any
+func (p *player) changed(c Change) {
+	if p.notify != nil {
+		p.notify(c)
+	}
 }
 
 func (p *player) See(w *World) {
 	p.Lock()
 	defer p.Unlock()
-	p.view.Compute(w, p.loc.X, p.loc.Y, 10)
+	p.view.Compute(w, p.loc.X, p.loc.Y, viewRadius)
+	p.viewVersion++
 	for point, _ := range p.view.Visible {
 		p.mapMem[Coord{point.X, point.Y}] = w.RenderForMemory(point.X, point.Y)
 	}
 }
 
+func (p *player) ViewVersion() int {
+	p.RLock()
+	defer p.RUnlock()
+	return p.viewVersion
+}
+
+// VisiblePoints lists every coordinate currently in the player's field of view
+func (p *player) VisiblePoints() []Coord {
+	p.RLock()
+	defer p.RUnlock()
+	out := make([]Coord, 0, len(p.view.Visible))
+	for point := range p.view.Visible {
+		out = append(out, Coord{point.X, point.Y})
+	}
+	return out
+}
+
 func (p *player) CanSee(x, y int) (bool, float64) {
 	p.RLock()
 	defer p.RUnlock()
@@ -106,7 +195,7 @@ func (p *player) CanSee(x, y int) (bool, float64) {
 }
 
 func (p *player) CanMove(now time.Time) bool {
-	return now.Sub(p.lastMoved) > time.Duration(int(500.*p.moveSpeed))*time.Millisecond
+	return now.Sub(p.lastMoved) > time.Duration(int(500.*p.moveSpeed*p.slowdown()))*time.Millisecond
 }
 
 func (p *player) AllVisited() map[Coord]string {
@@ -119,29 +208,46 @@ func (p *player) AllVisited() map[Coord]string {
 	return cpy
 }
 
-func (p *player) PickUp(i *Item, quantity int) int {
-	canCarry := math.Floor((p.maxCarry - p.carrying) / i.Weight)
-	pickedUp := int(math.Min(float64(quantity), canCarry))
-	if pickedUp > 0 {
-		if ii, ok := p.inventoryMap[i.ID]; ok {
-			ii.Quantity += pickedUp
-		} else {
-			nii := &InventoryItem{Item: i, Quantity: pickedUp}
-			p.inventory = append(p.inventory, nii)
-			p.inventoryMap[i.ID] = nii
-		}
-		p.carrying += float64(pickedUp) * i.Weight
+// PickUp takes as many of the item as the player can carry, which is more than fits in their pack
+// if they're willing to be overloaded. age is how far perishable food has got toward spoiling.
+func (p *player) PickUp(i *Item, quantity int, age float64) int {
+	was := p.overloaded()
+	canCarry := math.Floor((p.maxCarry*overloadLimit - p.carrying) / i.Weight)
+	pickedUp := int(math.Max(0, math.Min(float64(quantity), canCarry)))
+	if pickedUp == 0 {
+		p.Event(events.Warning, events.Harvesting, i18n.M("You're carrying too much to pick up the %s", i18n.Name(i.Name)))
+		return 0
 	}
-	p.Event(events.Success, fmt.Sprintf("You picked up %d x %s", pickedUp, i.Name))
+	p.stow(i, pickedUp, age)
+	p.Event(events.Success, events.Harvesting, i18n.M("You picked up %d x %s", pickedUp, i18n.Name(i.Name)))
+	p.warnLoad(was)
 	return pickedUp
 }
 
-func (p *player) ConsumeItem(i *Item) {
-	p.inventoryMap[i.ID].Quantity -= 1
-	if p.inventoryMap[i.ID].Quantity < 1 {
-		delete(p.inventoryMap, i.ID)
-		p.ReplaceInventory(p.inventoryMap)
+// give adds items to the inventory whether or not the player can carry them, eg when an item they
+// already have changes into another
+func (p *player) give(i *Item, quantity int) {
+	p.stow(i, quantity, 0)
+}
+
+// stow adds items of a given age to the inventory
+func (p *player) stow(i *Item, quantity int, age float64) {
+	if quantity < 1 {
+		return
+	}
+	if ii, ok := p.inventoryMap[i.ID]; ok {
+		ii.add(quantity, age)
+	} else {
+		nii := &InventoryItem{Item: i, Quantity: quantity, age: age}
+		p.inventory = append(p.inventory, nii)
+		p.inventoryMap[i.ID] = nii
 	}
+	p.carrying += float64(quantity) * i.Weight
+	p.changed(StatsChanged)
+}
+
+func (p *player) ConsumeItem(i *Item) {
+	p.RemoveItem(i.ID, 1)
 }
 
 func (p *player) Eat(nutrition float64) {
@@ -149,17 +255,31 @@ func (p *player) Eat(nutrition float64) {
 	if p.hunger < 0 {
 		p.hunger = 0
 	}
+	p.hungerLevel = levelOf(p.hunger)
+	p.changed(StatsChanged)
+	if p.hunger == 0 {
+		p.afflict(wellFed)
+	}
 }
 
-func (p *player) Attacked(w *World, damage int, message string) {
+// Exert makes the player hungrier, eg from shouting
+func (p *player) Exert(hunger float64) {
+	p.hunger += hunger
+	p.changed(StatsChanged)
+}
+
+// Attacked takes health from the player, telling them why. cause is what killed them if it's fatal.
+func (p *player) Attacked(w *World, damage int, message, cause i18n.Message) {
+	if p.dead {
+		return // eg starving and dehydrated in the same tick
+	}
 	p.health -= damage
-	p.Event(events.Danger, message)
+	p.changed(StatsChanged)
+	p.Event(events.Danger, events.Combat, message)
 	if p.health < 1 {
 		p.dead = true
+		p.cause = cause
 		w.PlayerDeath(p)
-		if p.onDeath != nil {
-			p.onDeath()
-		}
 	}
 }
 
@@ -171,6 +291,7 @@ func (p *player) SetActivity(a Activity) {
 	a.pBar = progress.New(progress.WithScaledGradient("#FF7CCB", "#FDFF8C"), progress.WithoutPercentage())
 	a.pBar.Width = 19
 	p.currentActivity = a
+	p.changed(StatsChanged)
 }
 
 func (p *player) Heal(h int) {
@@ -178,29 +299,134 @@ func (p *player) Heal(h int) {
 	if p.health > p.maxHealth {
 		p.health = p.maxHealth
 	}
+	p.changed(StatsChanged)
 }
 
 func (p *player) Inventory() []*InventoryItem {
 	return p.inventory
 }
 
+// ReplaceInventory swaps in a new inventory. items already carried keep their position, and new
+// items are appended in name order, so the inventory doesn't shuffle between views.
 func (p *player) ReplaceInventory(inv map[string]*InventoryItem) {
 	p.Lock()
 	defer p.Unlock()
-	p.inventoryMap = inv
-	ni := make([]*InventoryItem, 0)
-	for _, ii := range inv {
-		ni = append(ni, ii)
+	ni := make([]*InventoryItem, 0, len(inv))
+	kept := make(map[string]struct{})
+	for _, ii := range p.inventory {
+		if nii, ok := inv[ii.Item.ID]; ok && nii.Quantity > 0 {
+			ni = append(ni, nii)
+			kept[ii.Item.ID] = struct{}{}
+		}
+	}
+	added := make([]*InventoryItem, 0)
+	for id, ii := range inv {
+		if _, ok := kept[id]; !ok && ii.Quantity > 0 {
+			added = append(added, ii)
+		}
+	}
+	ni = append(ni, SortInventory(added, SortByName)...)
+	p.inventoryMap = make(map[string]*InventoryItem, len(ni))
+	p.carrying = 0
+	for _, ii := range ni {
+		p.inventoryMap[ii.Item.ID] = ii
+		p.carrying += ii.Weight()
 	}
 	p.inventory = ni
+	for id := range p.wearing {
+		if _, ok := p.inventoryMap[id]; !ok {
+			delete(p.wearing, id) // clothes that are dropped come off
+		}
+	}
+	p.changed(StatsChanged)
 }
 
-func (p *player) Event(kind events.Class, message string) {
-	p.events.Add(kind, message)
+// RemoveItem takes up to quantity of the item out of the inventory and returns how many were removed
+func (p *player) RemoveItem(id string, quantity int) int {
+	ii, ok := p.inventoryMap[id]
+	if !ok {
+		return 0
+	}
+	if quantity > ii.Quantity {
+		quantity = ii.Quantity
+	}
+	was := p.overloaded()
+	ii.Quantity -= quantity
+	p.carrying -= float64(quantity) * ii.Item.Weight
+	p.changed(StatsChanged)
+	p.warnLoad(was)
+	if ii.Quantity < 1 {
+		if p.wielding != nil && p.wielding.ID == id {
+			p.wielding = BareHands
+		}
+		delete(p.inventoryMap, id)
+		p.ReplaceInventory(p.inventoryMap)
+	}
+	return quantity
 }
 
+func (p *player) Event(kind events.Class, topic events.Topic, message i18n.Message) {
+	p.events.Add(kind, topic, message)
+	p.changed(EventsChanged)
+}
+
+// Events renders the most recent few events for the feed above the map
 func (p *player) Events() string {
-	return p.events.Render()
+	return p.events.RenderLast(playerFeedLength)
+}
+
+// mutes reports whether chat from the named player is hidden from this player
+func (p *player) mutes(name string) bool {
+	return p.muted.has(name)
+}
+
+// muteList is the lowercased names of players whose chat is hidden. it's read whenever chat is heard
+// or the feed is rendered, from any session's goroutine, so it has its own lock.
+type muteList struct {
+	sync.Mutex
+	names map[string]bool
+}
+
+func newMuteList() *muteList {
+	return &muteList{names: make(map[string]bool)}
+}
+
+func (m *muteList) has(name string) bool {
+	m.Lock()
+	defer m.Unlock()
+	return name != "" && m.names[strings.ToLower(name)]
+}
+
+// toggle mutes the named player, or unmutes them if they already were. it reports whether they're
+// muted now.
+func (m *muteList) toggle(name string) bool {
+	m.Lock()
+	defer m.Unlock()
+	key := strings.ToLower(name)
+	if m.names[key] {
+		delete(m.names, key)
+		return false
+	}
+	m.names[key] = true
+	return true
+}
+
+// list returns the muted names, sorted
+func (m *muteList) list() []string {
+	m.Lock()
+	defer m.Unlock()
+	out := make([]string, 0, len(m.names))
+	for name := range m.names {
+		out = append(out, name)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func (m *muteList) empty() bool {
+	m.Lock()
+	defer m.Unlock()
+	return len(m.names) == 0
 }
 
 func (p *player) GetLocation() (int, int) {
@@ -212,6 +438,6 @@ func (p *player) SetLocation(x, y int, t time.Time) {
 	p.loc = Coord{x, y}
 }
 
-func (p *player) OnDeath(f func()) {
-	p.onDeath = f
+func (p *player) OnKick(f func()) {
+	p.onKick = f
 }
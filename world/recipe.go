@@ -1,7 +1,8 @@
 package world
 
 import (
-	"strconv"
+	"github.com/dustmason/nicefort/i18n"
+	"math"
 	"strings"
 )
 
@@ -10,10 +11,50 @@ import (
 // something specific, etc
 
 type Recipe struct {
-	Description string
+	Description i18n.Message
 	Result      *Item
 	ID          int
 	condition   condition
+	trains      skill // the skill crafting it improves
+}
+
+// Requirement is one part of a recipe's condition, along with whether the player currently meets it.
+// Countable requirements (ingredients, traits) have a non-zero Need.
+type Requirement struct {
+	Description i18n.Message
+	Have        int
+	Need        int
+	Met         bool
+}
+
+func (r Requirement) String() string {
+	return r.Text(i18n.English)
+}
+
+// Text renders the requirement in the given language
+func (r Requirement) Text(l i18n.Locale) string {
+	if r.Need > 0 {
+		return l.T("%s %d/%d", r.Description.In(l), r.Have, r.Need)
+	}
+	return r.Description.In(l)
+}
+
+// RecipeStatus describes whether a recipe can be crafted right now, and why not
+type RecipeStatus struct {
+	Recipe
+	Craftable    bool
+	Requirements []Requirement
+}
+
+// Missing returns only the requirements that are not met
+func (rs RecipeStatus) Missing() []Requirement {
+	out := make([]Requirement, 0)
+	for _, r := range rs.Requirements {
+		if !r.Met {
+			out = append(out, r)
+		}
+	}
+	return out
 }
 
 func AvailableRecipes(im map[string]*InventoryItem, e *entity, w *World) []Recipe {
@@ -39,89 +80,189 @@ func FindRecipe(id int) (bool, Recipe) {
 	return ok, found
 }
 
-type condition func(map[string]*InventoryItem, *entity, *World) (bool, map[string]int)
+// RecipeStatuses reports on every recipe in AllRecipes, craftable or not
+func RecipeStatuses(im map[string]*InventoryItem, e *entity, w *World) []RecipeStatus {
+	out := make([]RecipeStatus, 0, len(AllRecipes))
+	for _, r := range AllRecipes {
+		out = append(out, r.Status(im, e, w))
+	}
+	return out
+}
+
+// a condition returns whether it passed, the inventory it would consume, and the requirements it checked
+type condition func(map[string]*InventoryItem, *entity, *World) (bool, map[string]int, []Requirement)
 
-func newRecipe(result *Item, id int, description string, conditions ...condition) Recipe {
-	mergedConditions := func(ii map[string]*InventoryItem, e *entity, w *World) (bool, map[string]int) {
+func newRecipe(result *Item, id int, description i18n.Message, conditions ...condition) Recipe {
+	mergedConditions := func(ii map[string]*InventoryItem, e *entity, w *World) (bool, map[string]int, []Requirement) {
 		out := make(map[string]int)
+		reqs := make([]Requirement, 0)
+		allOk := true
+		// evaluate every condition, even after one fails, so the player can see everything that's missing
 		for _, f := range conditions {
-			ok, newInv := f(ii, e, w)
+			ok, newInv, r := f(ii, e, w)
+			reqs = append(reqs, r...)
 			if !ok {
-				return false, out
+				allOk = false
+				continue
 			}
 			for s, q := range newInv {
 				out[s] += q
 			}
 		}
-		return true, out
+		if !allOk {
+			return false, make(map[string]int), reqs
+		}
+		return true, out, reqs
 	}
 	return Recipe{
 		Description: description,
 		condition:   mergedConditions,
 		Result:      result,
 		ID:          id,
+		trains:      crafting,
 	}
 
 }
 
 func newSimpleRecipe(result *Item, id int, ing ...InventoryItem) Recipe {
 	parts := make([]string, len(ing))
+	args := make([]interface{}, 0, len(ing)*2)
 	for i, ii := range ing {
-		parts[i] = ii.Item.Name + " x " + strconv.Itoa(ii.Quantity)
+		parts[i] = "%s x %d"
+		args = append(args, i18n.Name(ii.Item.Name), ii.Quantity)
+	}
+	return newRecipe(result, id, i18n.M(strings.Join(parts, ", "), args...), ingredientsCondition(ing...))
+}
+
+// training makes crafting the recipe improve a skill other than crafting
+func (r Recipe) training(s skill) Recipe {
+	r.trains = s
+	return r
+}
+
+// requiring adds a minimum level in a skill to the recipe's condition
+func (r Recipe) requiring(s skill, level int) Recipe {
+	c := r.condition
+	sc := skillCondition(s, level)
+	r.condition = func(ii map[string]*InventoryItem, e *entity, w *World) (bool, map[string]int, []Requirement) {
+		ok, cost, reqs := c(ii, e, w)
+		skilled, _, skillReqs := sc(ii, e, w)
+		if !skilled {
+			return false, make(map[string]int), append(reqs, skillReqs...)
+		}
+		return ok, cost, append(reqs, skillReqs...)
 	}
-	return newRecipe(result, id, strings.Join(parts, ", "), ingredientsCondition(ing...))
+	return r
 }
 
 func (r *Recipe) Check(inv map[string]*InventoryItem, e *entity, w *World) bool {
-	ok, _ := r.condition(inv, e, w)
+	ok, _, _ := r.condition(inv, e, w)
 	return ok
 }
 
+func (r *Recipe) Status(inv map[string]*InventoryItem, e *entity, w *World) RecipeStatus {
+	ok, _, reqs := r.condition(inv, e, w)
+	return RecipeStatus{Recipe: *r, Craftable: ok, Requirements: reqs}
+}
+
+// Do crafts the recipe from inv. food made from perishable ingredients has already been kept as many
+// days as the oldest of them, counted against its own shelf life, so smoking or drying food extends
+// how long it keeps without making spoiled food good again.
 func (r *Recipe) Do(inv map[string]*InventoryItem, e *entity, w *World) (bool, map[string]*InventoryItem) {
-	ok, cost := r.condition(inv, e, w)
+	ok, cost, _ := r.condition(inv, e, w)
 	if !ok {
 		return false, inv
 	}
+	age := 0.
 	for id, q := range cost {
+		if ii := inv[id]; ii.Item.Perishable() && r.Result.Perishable() {
+			a := ii.age * ii.Item.shelfLife / r.Result.shelfLife
+			if ii.freshness() == spoiled {
+				a = 1
+			}
+			age = math.Max(age, math.Min(1, a))
+		}
 		inv[id].Quantity -= q
 	}
 	if _, ok := inv[r.Result.ID]; !ok {
 		inv[r.Result.ID] = &InventoryItem{Item: r.Result}
 	}
-	inv[r.Result.ID].Quantity += 1
+	inv[r.Result.ID].add(1, age)
 	return true, inv
 }
 
-// todo make condition func that checks if we are standing next to a cooking fire
-// and if we have a watertight cooking vessel
+// todo make condition func that checks if we have a watertight cooking vessel
 
 func ingredientsCondition(ingredients ...InventoryItem) condition {
-	return func(inventoryMap map[string]*InventoryItem, e *entity, w *World) (bool, map[string]int) {
+	return func(inventoryMap map[string]*InventoryItem, e *entity, w *World) (bool, map[string]int, []Requirement) {
 		out := make(map[string]int)
+		reqs := make([]Requirement, 0, len(ingredients))
+		allOk := true
 		for _, i := range ingredients {
-			pi, ok := inventoryMap[i.Item.ID]
-			if !ok || pi.Quantity < i.Quantity {
-				return false, out
+			have := 0
+			if pi, ok := inventoryMap[i.Item.ID]; ok {
+				have = pi.Quantity
+			}
+			met := have >= i.Quantity
+			reqs = append(reqs, Requirement{Description: i18n.M(i.Item.Name), Have: have, Need: i.Quantity, Met: met})
+			if !met {
+				allOk = false
+				continue
 			}
 			out[i.Item.ID] = i.Quantity
 		}
-		return true, out
+		return allOk, out, reqs
 	}
 }
 
 // todo this should be smarter and should allow a combination of many items that have the right traits
 func traitMatchingCondition(trait ItemTraits, quantity int) condition {
-	return func(inventoryMap map[string]*InventoryItem, e *entity, w *World) (bool, map[string]int) {
+	return func(inventoryMap map[string]*InventoryItem, e *entity, w *World) (bool, map[string]int, []Requirement) {
 		// look at inventoryMap and find the first item that matches trait, and that we have `quantity` of
 		out := make(map[string]int)
+		best := 0
 		for _, ii := range inventoryMap {
 			// does this item have the trait?
 			if ii.Item.HasTrait(trait) && ii.Quantity >= quantity {
 				out[ii.Item.ID] = quantity
-				return true, out
+				return true, out, []Requirement{{Description: i18n.M(trait.String()), Have: ii.Quantity, Need: quantity, Met: true}}
+			}
+			if ii.Item.HasTrait(trait) && ii.Quantity > best {
+				best = ii.Quantity
 			}
 		}
-		return false, out
+		return false, out, []Requirement{{Description: i18n.M(trait.String()), Have: best, Need: quantity}}
+	}
+}
+
+// nearItemCondition requires a (usually nonPortable) item to be placed within radius of the player
+func nearItemCondition(item *Item, radius int) condition {
+	return func(inventoryMap map[string]*InventoryItem, e *entity, w *World) (bool, map[string]int, []Requirement) {
+		req := Requirement{Description: i18n.M("near a %s", i18n.Name(item.Name))}
+		x, y := e.player.GetLocation()
+		for iy := y - radius; iy <= y+radius; iy++ {
+			for ix := x - radius; ix <= x+radius; ix++ {
+				if !w.InBounds(ix, iy) {
+					continue
+				}
+				for _, ent := range w.location(ix, iy) {
+					if ent.item != nil && ent.item.ID == item.ID {
+						req.Met = true
+						return true, make(map[string]int), []Requirement{req}
+					}
+				}
+			}
+		}
+		return false, make(map[string]int), []Requirement{req}
+	}
+}
+
+// wieldingCondition requires the player to be wielding a tool with the given trait
+func wieldingCondition(trait ItemTraits) condition {
+	return func(inventoryMap map[string]*InventoryItem, e *entity, w *World) (bool, map[string]int, []Requirement) {
+		req := Requirement{Description: i18n.M("wielding a %s", i18n.Name(trait.String()))}
+		req.Met = e.player.wielding != nil && e.player.wielding.HasTrait(trait)
+		return req.Met, make(map[string]int), []Requirement{req}
 	}
 }
 
@@ -131,14 +272,56 @@ var AllRecipes = []Recipe{
 	newRecipe(
 		FireStarterBow,
 		3,
-		"Twine x 3, Sticks x 3, Kindling x 3",
+		i18n.M("Twine x 3, Sticks x 3, Kindling x 3"),
 		ingredientsCondition(InventoryItem{Item: Twine, Quantity: 3}),
 		traitMatchingCondition(Kindling, 3),
 		traitMatchingCondition(Stick, 3),
-	),
+	).training(fireMaking),
 	newRecipe(Campfire, 4,
-		"A fire starter bow and some fuel (wood).",
+		i18n.M("A fire starter bow and some fuel (wood)."),
 		ingredientsCondition(InventoryItem{Item: FireStarterBow, Quantity: 1}),
 		traitMatchingCondition(Fuel, 1),
+	).training(fireMaking),
+	newSimpleRecipe(BarkFlask, 5, InventoryItem{Item: DownyBirchBark, Quantity: 2}, InventoryItem{Item: Twine, Quantity: 1}),
+	newSimpleRecipe(BarkShoes, 6, InventoryItem{Item: DownyBirchBark, Quantity: 4}, InventoryItem{Item: Twine, Quantity: 2}).requiring(crafting, 1),
+	newSimpleRecipe(BarkCloak, 7, InventoryItem{Item: PineBark, Quantity: 6}, InventoryItem{Item: Twine, Quantity: 3}).requiring(crafting, 2),
+	newSimpleRecipe(Bedroll, 8, InventoryItem{Item: AspenBark, Quantity: 6}, InventoryItem{Item: Twine, Quantity: 2}).requiring(crafting, 1),
+	newRecipe(FlaskOfSpruceTea, 9,
+		i18n.M("Spruce Shoots x 3 and a flask of water, brewed at a campfire"),
+		ingredientsCondition(InventoryItem{Item: SpruceShoots, Quantity: 3}, InventoryItem{Item: FlaskOfWater, Quantity: 1}),
+		identifiedCondition(SpruceShoots),
+		nearItemCondition(Campfire, remedyRadius),
+	),
+	newRecipe(FlaskOfWillowTea, 10,
+		i18n.M("Glaucous Willow Catkins x 3 and a flask of water, brewed at a campfire"),
+		ingredientsCondition(InventoryItem{Item: GlaucousWillowCatkins, Quantity: 3}, InventoryItem{Item: FlaskOfWater, Quantity: 1}),
+		identifiedCondition(GlaucousWillowCatkins),
+		nearItemCondition(Campfire, remedyRadius),
+	),
+	newRecipe(WillowPoultice, 11,
+		i18n.M("Halberd Leaved Willow Leaves x 4 and Twine x 1"),
+		ingredientsCondition(InventoryItem{Item: HalberdLeavedWillowLeaves, Quantity: 4}, InventoryItem{Item: Twine, Quantity: 1}),
+		identifiedCondition(HalberdLeavedWillowLeaves),
+	),
+	newRecipe(RoastMeat, 12,
+		i18n.M("Raw meat x 1, cooked at a campfire"),
+		ingredientsCondition(InventoryItem{Item: RawMeat, Quantity: 1}),
+		nearItemCondition(Campfire, cookingRadius),
+	).training(fireMaking),
+	newRecipe(SmokedMeat, 13,
+		i18n.M("Raw meat x 2 and some fuel, smoked over a campfire"),
+		ingredientsCondition(InventoryItem{Item: RawMeat, Quantity: 2}),
+		traitMatchingCondition(Fuel, 1),
+		nearItemCondition(Campfire, cookingRadius),
+	).training(fireMaking),
+	newRecipe(DriedCloudberries, 14,
+		i18n.M("Cloudberries x 5, spread out to dry on a dry day"),
+		ingredientsCondition(InventoryItem{Item: Cloudberries, Quantity: 5}),
+		dryWeatherCondition(),
 	),
+	newRecipe(BakedBirdCherries, 15,
+		i18n.M("Bird Cherries x 4, baked at a campfire"),
+		ingredientsCondition(InventoryItem{Item: BirdCherries, Quantity: 4}),
+		nearItemCondition(Campfire, cookingRadius),
+	).training(fireMaking),
 }
@@ -0,0 +1,205 @@
+package world
+
+import (
+	"github.com/dustmason/nicefort/events"
+	"github.com/dustmason/nicefort/i18n"
+	"math/rand"
+	"time"
+)
+
+const identifyChance = 0.3   // that an unskilled player works out what a herb does by trying it
+const identifyPerLevel = 0.1 // added for each level of foraging
+const identifyXP = 5.        // foraging xp for identifying a herb
+const remedyRadius = 1       // how close to a fire teas are brewed
+
+// remedy is what a medicinal item does when it's used
+type remedy struct {
+	herb    bool   // a raw plant, which has to be identified before it does anything
+	use     string // what the player learns it does, for the inventory
+	message string // told to the player when they use it
+	heal    int
+	cures   []*effectKind
+	grants  *effectKind
+}
+
+// treat gives the player everything the remedy does
+func (r *remedy) treat(p *player) {
+	if r.heal > 0 {
+		p.Heal(r.heal)
+	}
+	for _, k := range r.cures {
+		p.relieve(k)
+	}
+	if r.grants != nil {
+		p.afflict(r.grants)
+	}
+}
+
+// remedies are keyed by item id. herbs have to be identified before they do anything; what's made
+// from them doesn't.
+var remedies = map[string]*remedy{
+	"spruce-shoots": {
+		herb:    true,
+		use:     "Chewed, it soothes a little. Brewed into tea, it settles the stomach",
+		message: "The spruce shoots are sharp and resinous",
+		heal:    2,
+	},
+	"glaucous-willow-catkins": {
+		herb:    true,
+		use:     "Eases pain. Brewed into tea, it eases it a lot more",
+		message: "The catkins are bitter, and your aches fade a little",
+		heal:    4,
+	},
+	"bog-myrtle-leaves": {
+		herb:    true,
+		use:     "Rubbed on the skin, it keeps biting insects away",
+		message: "You rub the bog myrtle on your skin. It smells sweet and resinous",
+		grants:  repelled,
+	},
+	"halberd-leaved-willow-leaves": {
+		herb:    true,
+		use:     "Soothes insect bites. Bound into a poultice, it stops bleeding too",
+		message: "You press the willow leaves onto your bites",
+		cures:   []*effectKind{insectBitten},
+	},
+	"flask-of-spruce-tea": {
+		use:     "Settles the stomach and draws out poison",
+		message: "The spruce tea is warm and settles your stomach",
+		heal:    5,
+		cures:   []*effectKind{poisoned},
+	},
+	"flask-of-willow-tea": {
+		use:     "Dulls pain and helps wounds heal",
+		message: "The willow tea is bitter, but the pain ebbs away",
+		heal:    12,
+		grants:  numbed,
+	},
+	"willow-poultice": {
+		use:     "Stops bleeding and soothes insect bites",
+		message: "You bind the poultice to your wounds",
+		heal:    5,
+		cures:   []*effectKind{bleeding, insectBitten},
+	},
+}
+
+// identified is true for anything that's not a herb, and for herbs the player has worked out
+func (p *player) identified(id string) bool {
+	if r, ok := remedies[id]; !ok || !r.herb {
+		return true
+	}
+	return p.known[id]
+}
+
+// activateHerb uses a medicinal plant. until the player has identified it they're only guessing,
+// and it does nothing but teach them, if they're lucky. the better they are at foraging, the sooner
+// they work it out.
+func activateHerb(i *Item, e *entity, w *World) (bool, i18n.Message) {
+	p := e.player
+	r := remedies[i.ID]
+	if !p.identified(i.ID) {
+		if rand.Float64() >= identifyChance+identifyPerLevel*float64(p.level(foraging)) {
+			return true, i18n.M("You try the %s, but you can't tell what it does", i18n.Name(i.Name))
+		}
+		p.known[i.ID] = true
+		p.train(foraging, identifyXP)
+		p.Event(events.Success, events.System, i18n.M("You've worked out what the %s is good for: %s", i18n.Name(i.Name), i18n.Name(r.use)))
+	}
+	r.treat(p)
+	return true, i18n.M(r.message)
+}
+
+// activateRemedy uses a tea or poultice
+func activateRemedy(i *Item, e *entity, w *World) (bool, i18n.Message) {
+	remedies[i.ID].treat(e.player)
+	return true, i18n.M(remedies[i.ID].message)
+}
+
+// drinkTea is a remedy that also quenches thirst, and leaves an empty flask
+func drinkTea(i *Item, e *entity, w *World) (bool, i18n.Message) {
+	e.player.Drink(false)
+	e.player.give(BarkFlask, 1)
+	return activateRemedy(i, e, w)
+}
+
+// identifiedCondition requires the player to know what a herb does before using it in a recipe
+func identifiedCondition(herb *Item) condition {
+	return func(inventoryMap map[string]*InventoryItem, e *entity, w *World) (bool, map[string]int, []Requirement) {
+		met := e.player.identified(herb.ID)
+		have := 0
+		if met {
+			have = 1
+		}
+		return met, make(map[string]int), []Requirement{{Description: i18n.M("know what %s is", i18n.Name(herb.Name)), Have: have, Need: 1, Met: met}}
+	}
+}
+
+// RemedyNote describes what a medicinal item does, as far as the player knows. ok is false for
+// items that aren't medicinal.
+func (w *World) RemedyNote(playerID, itemID string) (note i18n.Message, ok bool) {
+	r, ok := remedies[itemID]
+	if !ok {
+		return i18n.Message{}, false
+	}
+	e, ok := w.getPlayer(playerID)
+	if !ok {
+		return i18n.Message{}, false
+	}
+	if !e.player.identified(itemID) {
+		return i18n.M("You don't know what this does. Try it to find out"), true
+	}
+	return i18n.M(r.use), true
+}
+
+var FlaskOfSpruceTea = newItem(
+	"flask-of-spruce-tea",
+	"flask of spruce tea",
+	"u ",
+	"#5B7B1A",
+	1.1,
+	0,
+	0,
+	false,
+	drinkTea,
+)
+var FlaskOfWillowTea = newItem(
+	"flask-of-willow-tea",
+	"flask of willow tea",
+	"u ",
+	"#CFBDA8",
+	1.1,
+	0,
+	0,
+	false,
+	drinkTea,
+)
+var WillowPoultice = newItem(
+	"willow-poultice",
+	"willow poultice",
+	"+ ",
+	"#7E9D0B",
+	0.1,
+	0,
+	0,
+	false,
+	activateRemedy,
+)
+
+var repelled = &effectKind{
+	name:     "Insect repellent",
+	color:    "#778872",
+	duration: 3 * time.Minute,
+	stacking: stackRefresh,
+	onset:    "Biting insects keep their distance",
+	wearsOff: "The bog myrtle's scent has faded",
+}
+
+var numbed = &effectKind{
+	name:     "Numbed",
+	color:    "#CFBDA8",
+	duration: 90 * time.Second,
+	stacking: stackRefresh,
+	slowdown: 0.9,
+	strength: 1.1,
+	onset:    "The willow dulls your pain",
+	wearsOff: "Your aches are back",
+}
@@ -0,0 +1,129 @@
+package world
+
+import (
+	"github.com/charmbracelet/lipgloss"
+	"sync"
+)
+
+// distanceBuckets is how many steps of distance shading are used for visible tiles. distances are
+// rounded into buckets so that styled tiles can be cached and shared by every session.
+const distanceBuckets = 16
+
+type entityKind int
+
+const (
+	environmentKind entityKind = iota
+	floraKind
+	itemKind
+	npcKind
+	playerKind
+)
+
+// appearance identifies everything that determines how an entity is drawn
+type appearance struct {
+	kind    entityKind
+	id      string
+	env     Environment
+	variant int
+}
+
+func (e entity) appearance() appearance {
+	switch {
+	case e.player != nil:
+		return appearance{kind: playerKind, id: e.String()}
+	case e.npc != nil:
+		return appearance{kind: npcKind, id: e.String()}
+	case e.item != nil:
+		return appearance{kind: itemKind, id: e.item.ID}
+	case e.flora != nil:
+		return appearance{kind: floraKind, id: e.flora.id}
+	}
+	return appearance{kind: environmentKind, env: e.environment, variant: e.variant}
+}
+
+type tileKey struct {
+	fg     appearance
+	bg     appearance
+	bucket int
+}
+
+// tileCache holds pre-styled tile strings, shared between all sessions
+type tileCache struct {
+	sync.RWMutex
+	tiles map[tileKey]string
+}
+
+var tiles = &tileCache{tiles: make(map[tileKey]string)}
+
+func distanceBucket(dist float64) int {
+	b := int(dist * distanceBuckets)
+	if b >= distanceBuckets {
+		b = distanceBuckets - 1
+	}
+	if b < 0 {
+		b = 0
+	}
+	return b
+}
+
+// render returns the styled string for fg drawn over bg at the given distance from the viewer
+func (tc *tileCache) render(fg, bg *entity, dist float64) string {
+	key := tileKey{fg: fg.appearance(), bg: bg.appearance(), bucket: distanceBucket(dist)}
+	tc.RLock()
+	s, ok := tc.tiles[key]
+	tc.RUnlock()
+	if ok {
+		return s
+	}
+	bucketDist := float64(key.bucket) / distanceBuckets
+	s = lipgloss.NewStyle().
+		Foreground(lipgloss.Color(fg.ForegroundColor(bucketDist))).
+		Background(lipgloss.Color(bg.BackgroundColor(bucketDist))).
+		Render(fg.String())
+	tc.Lock()
+	tc.tiles[key] = s
+	tc.Unlock()
+	return s
+}
+
+// MapFrame caches the last map a session rendered. RenderMap reuses it when neither the viewer nor
+// any tile in the viewport has changed since.
+type MapFrame struct {
+	key     frameKey
+	version uint64
+	frame   string
+}
+
+type frameKey struct {
+	x, y, vw, vh int
+	viewVersion  int
+}
+
+func NewMapFrame() *MapFrame {
+	return &MapFrame{}
+}
+
+// setLocation replaces the contents of a tile and marks it as changed
+func (w *World) setLocation(i int, l location) {
+	w.wMap[i] = l
+	w.touch(i)
+}
+
+// touch marks a tile as changed so that cached frames containing it are re-rendered
+func (w *World) touch(i int) {
+	w.version++
+	w.touched[i] = w.version
+	w.notifyNearby(i)
+}
+
+// changedSince reports whether any tile within the rectangle changed after version
+func (w *World) changedSince(version uint64, left, top, right, bottom int) bool {
+	for iy := top; iy < bottom; iy++ {
+		for ix := left; ix < right; ix++ {
+			if w.InBounds(ix, iy) && w.touched[w.index(ix, iy)] > version {
+				return true
+			}
+		}
+	}
+	return false
+}
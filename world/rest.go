@@ -0,0 +1,211 @@
+package world
+
+import (
+	"github.com/dustmason/nicefort/events"
+	"github.com/dustmason/nicefort/i18n"
+	"math"
+)
+
+const regenRate = 1.0 / 60             // health regained per second by a well fed, warm player
+const restRegen = 3                    // resting heals this much faster
+const sleepRegen = 6                   // and sleeping faster still
+const fatigueRate = hungerRate         // how much fatigue builds per second just from being awake
+const walkFatigue = 0.001              // for each step
+const harvestFatigue = 0.005           // for each blow at a plant
+const fightFatigue = 0.01              // for each blow at an animal
+const restRecovery = 10 * fatigueRate  // fatigue shed per second while resting
+const sleepRecovery = 60 * fatigueRate // and while sleeping. a night's sleep takes a minute or so
+const sleepyFatigue = 0.25             // a player can't fall asleep until they're at least this tired
+const bedrollRadius = 1                // how close a dropped Bedroll has to be to sleep on it
+
+// rest is whether a player is up and about, or resting or sleeping to recover
+type rest int
+
+const (
+	awake rest = iota
+	resting
+	sleeping
+)
+
+func (r rest) String() string {
+	switch r {
+	case resting:
+		return "Resting"
+	case sleeping:
+		return "Sleeping"
+	}
+	return "Awake"
+}
+
+var fatigueNeed = need{
+	meter:  "Fatigue: %s",
+	labels: []string{"Rested", "Tired", "Weary", "Exhausted"},
+	warnings: []string{
+		"",
+		"You're getting tired",
+		"You're weary. You move and work more slowly",
+		"You're exhausted! Rest or sleep before you drop",
+	},
+}
+
+// recover regains health and sheds fatigue for elapsed seconds. healing slows when the player is
+// hungry, thirsty or cold and stops altogether once any of those gets severe.
+func (p *player) recover(elapsed float64, s surroundings) {
+	switch p.rest {
+	case resting:
+		p.tire(-elapsed * restRecovery)
+	case sleeping:
+		p.tire(-elapsed * sleepRecovery)
+		if p.fatigue == 0 {
+			p.rest = awake
+			p.Event(events.Success, events.System, i18n.M("You wake up rested"))
+			p.changed(StatsChanged)
+		}
+	default:
+		p.tire(elapsed * fatigueRate)
+	}
+	if p.rest != awake && s.danger {
+		p.wake(i18n.M("Something dangerous is close. You get up"))
+	}
+	if p.health >= p.maxHealth {
+		p.regen = 0
+		return
+	}
+	rate := regenRate
+	switch p.rest {
+	case resting:
+		rate *= restRegen
+	case sleeping:
+		rate *= sleepRegen
+	}
+	worst := p.hungerLevel
+	for _, l := range []needLevel{p.thirstLevel, p.coldLevel} {
+		if l > worst {
+			worst = l
+		}
+	}
+	switch worst {
+	case needMild:
+		rate /= 2
+	case needSevere, needCritical:
+		rate = 0
+	}
+	p.regen += elapsed * rate
+	if p.regen >= 1 {
+		p.Heal(int(p.regen))
+		p.regen -= math.Floor(p.regen)
+	}
+}
+
+// tire adds (or with a negative amount, removes) fatigue
+func (p *player) tire(amount float64) {
+	before := math.Round(p.fatigue * 100)
+	p.fatigue = math.Max(0, p.fatigue+amount)
+	if math.Round(p.fatigue*100) != before {
+		p.changed(StatsChanged)
+	}
+}
+
+// wake gets a resting or sleeping player back on their feet, telling them why
+func (p *player) wake(message i18n.Message) {
+	if p.rest == awake {
+		return
+	}
+	p.rest = awake
+	p.Event(events.Info, events.System, message)
+	p.changed(StatsChanged)
+}
+
+// dangerNearby is true when a hostile animal is within sight of the player. the caller must hold the
+// world lock.
+func (w *World) dangerNearby(p *player) bool {
+	for _, e := range w.activeNPCs {
+		if e.npc.aggressive && e.npc.loc.Distance(p.loc) <= viewRadius {
+			return true
+		}
+	}
+	return false
+}
+
+// bedrollNearby is true when the player carries a Bedroll or one has been laid out close by
+func (w *World) bedrollNearby(p *player) bool {
+	if _, ok := p.inventoryMap[Bedroll.ID]; ok {
+		return true
+	}
+	x, y := p.GetLocation()
+	for iy := y - bedrollRadius; iy <= y+bedrollRadius; iy++ {
+		for ix := x - bedrollRadius; ix <= x+bedrollRadius; ix++ {
+			if !w.InBounds(ix, iy) {
+				continue
+			}
+			for _, ent := range w.location(ix, iy) {
+				if ent.item != nil && ent.item.ID == Bedroll.ID {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// RestPlayer sits the player down to recover, or gets them back up if they're already resting
+func (w *World) RestPlayer(playerID string) {
+	e, ok := w.getPlayer(playerID)
+	if !ok {
+		return
+	}
+	w.Lock()
+	defer w.Unlock()
+	p := e.player
+	if p.rest != awake {
+		p.wake(i18n.M("You get up"))
+		return
+	}
+	if w.dangerNearby(p) {
+		p.Event(events.Warning, events.System, i18n.M("You can't rest with danger nearby"))
+		return
+	}
+	p.rest = resting
+	p.Event(events.Info, events.System, i18n.M("You sit down to rest"))
+	p.changed(StatsChanged)
+}
+
+// SleepPlayer puts the player to sleep until they're rested, if they have somewhere to lie down
+func (w *World) SleepPlayer(playerID string) {
+	e, ok := w.getPlayer(playerID)
+	if !ok {
+		return
+	}
+	w.Lock()
+	defer w.Unlock()
+	p := e.player
+	if p.rest == sleeping {
+		p.wake(i18n.M("You get up"))
+		return
+	}
+	x, y := p.GetLocation()
+	switch {
+	case w.dangerNearby(p):
+		p.Event(events.Warning, events.System, i18n.M("You can't sleep with danger nearby"))
+	case !w.sheltered(x, y) && !w.bedrollNearby(p):
+		p.Event(events.Warning, events.System, i18n.M("You need a bedroll or shelter to sleep"))
+	case p.fatigue < sleepyFatigue:
+		p.Event(events.Warning, events.System, i18n.M("You aren't tired enough to sleep"))
+	default:
+		p.rest = sleeping
+		p.Event(events.Info, events.System, i18n.M("You lie down and fall asleep"))
+		p.changed(StatsChanged)
+	}
+}
+
+var Bedroll = newItem(
+	"bedroll",
+	"bedroll",
+	"= ",
+	"#848582",
+	1.5,
+	0,
+	0,
+	false,
+	nil,
+)
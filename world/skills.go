@@ -0,0 +1,108 @@
+package world
+
+import (
+	"github.com/dustmason/nicefort/events"
+	"github.com/dustmason/nicefort/i18n"
+	"math"
+	"math/rand"
+)
+
+const maxSkill = 10
+const skillCurve = 20.    // xp for level 1. each level n needs skillCurve * n^2
+const harvestXP = 1.      // for each blow at a plant
+const yieldXP = 5.        // when a plant yields something
+const hitXP = 2.          // for each blow landed on an animal
+const killXP = 20.        // for killing it
+const craftXP = 10.       // for crafting anything
+const baseHitChance = 0.6 // how often an unskilled player lands a blow
+const hitChancePerLevel = 0.04
+
+// skill is something a player gets better at by doing it
+type skill int
+
+const (
+	woodcutting skill = iota
+	foraging
+	crafting
+	combat
+	fireMaking
+	numSkills
+)
+
+var skillNames = []string{"Woodcutting", "Foraging", "Crafting", "Combat", "Fire-making"}
+
+func (s skill) String() string {
+	return skillNames[s]
+}
+
+// skillLevel is the level reached with xp, from 0 to maxSkill
+func skillLevel(xp float64) int {
+	return int(math.Min(maxSkill, math.Floor(math.Sqrt(xp/skillCurve))))
+}
+
+func (p *player) level(s skill) int {
+	return skillLevel(p.xp[s])
+}
+
+// train gives the player xp in a skill, telling them when they reach a new level
+func (p *player) train(s skill, xp float64) {
+	before := p.level(s)
+	p.xp[s] += xp
+	if after := p.level(s); after > before {
+		p.Event(events.Success, events.System, i18n.M("Your %s skill is now %d", i18n.Name(s.String()), after))
+		p.changed(StatsChanged)
+	}
+}
+
+// proficiency multiplies the power of what a player does with a skill: 10% better for each level
+func (p *player) proficiency(s skill) float64 {
+	return 1 + 0.1*float64(p.level(s))
+}
+
+// harvestSkill is the skill used to harvest with the item: woodcutting with an axe, otherwise foraging
+func harvestSkill(with *Item) skill {
+	if with.HasTrait(Axe) {
+		return woodcutting
+	}
+	return foraging
+}
+
+// bonusYield gives a skilled player a chance at extra items. each level is a 5% chance per item.
+func (p *player) bonusYield(s skill, drops []InventoryItem) []InventoryItem {
+	chance := 0.05 * float64(p.level(s))
+	out := make([]InventoryItem, len(drops))
+	for i, d := range drops {
+		out[i] = d
+		for n := 0; n < d.Quantity; n++ {
+			if rand.Float64() < chance {
+				out[i].Quantity++
+			}
+		}
+	}
+	return out
+}
+
+// hits decides whether the player lands a blow, which is more likely the better they are at combat
+func (p *player) hits() bool {
+	return rand.Float64() < baseHitChance+hitChancePerLevel*float64(p.level(combat))
+}
+
+// renderSkills lists the skills the player has any level in, eg "Combat 2"
+func (p *player) renderSkills(l i18n.Locale) []string {
+	out := make([]string, 0)
+	for s := skill(0); s < numSkills; s++ {
+		if lvl := p.level(s); lvl > 0 {
+			out = append(out, l.T("%s %d", i18n.Name(s.String()), lvl))
+		}
+	}
+	return out
+}
+
+// skillCondition requires the player to have reached a level in a skill
+func skillCondition(s skill, level int) condition {
+	return func(inventoryMap map[string]*InventoryItem, e *entity, w *World) (bool, map[string]int, []Requirement) {
+		have := e.player.level(s)
+		met := have >= level
+		return met, make(map[string]int), []Requirement{{Description: i18n.M("%s skill", i18n.Name(s.String())), Have: have, Need: level, Met: met}}
+	}
+}
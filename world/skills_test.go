@@ -0,0 +1,25 @@
+package world
+
+import "testing"
+
+func TestSkillLevel(t *testing.T) {
+	tests := []struct {
+		xp   float64
+		want int
+	}{
+		{0, 0},
+		{19.9, 0},
+		{20, 1},
+		{79.9, 1},
+		{80, 2},
+		{180, 3},
+		{1999.9, 9},
+		{2000, 10},
+		{1e9, maxSkill},
+	}
+	for _, tt := range tests {
+		if got := skillLevel(tt.xp); got != tt.want {
+			t.Errorf("skillLevel(%v) = %d, want %d", tt.xp, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,244 @@
+package world
+
+import (
+	"github.com/dustmason/nicefort/i18n"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+const normalBodyTemp = 37.0 // °C
+const comfortTemp = 5.0     // players lose heat when what they feel is colder than this
+const coolingRate = 0.0003  // °C of body heat lost per second, per degree below comfortTemp
+const warmingRate = 0.01    // °C of body heat regained per second when comfortable
+const lapseRate = 6.5       // °C colder per 1,000m of elevation
+const campfireRadius = 3    // how far away a Campfire warms you
+const campfireWarmth = 25.0 // °C
+const shelterRadius = 6     // how far walls can be from the player to count as shelter
+const shelterWarmth = 10.0  // °C, on top of keeping out the weather
+
+// seasonTemperatures is the average temperature of each season in seasons, in °C
+var seasonTemperatures = []float64{2, 15, 3, -15}
+
+type weather int
+
+const (
+	clearWeather weather = iota
+	cloudyWeather
+	precipitation // rain or snow, depending on the season
+	storm
+)
+
+var weatherNames = []string{"Clear", "Cloudy", "Rain", "Storm"}
+var weatherChill = []float64{0, 1, 3, 8}   // °C colder, outside
+var weatherWeights = []int{40, 30, 20, 10} // how likely each kind of weather is
+var weatherAnnouncements = []string{
+	"The sky clears",
+	"Clouds roll in",
+	"It starts to rain",
+	"A storm is coming",
+}
+
+func (w weather) String() string {
+	return weatherNames[w]
+}
+
+// changeWeather picks the next weather and how long it lasts, between a third of a day and a day and a
+// half. the caller must hold the world lock, and announce the weather after unlocking if it changed.
+func (w *World) changeWeather() bool {
+	total := 0
+	for _, n := range weatherWeights {
+		total += n
+	}
+	r := rand.Intn(total)
+	next := clearWeather
+	for i, n := range weatherWeights {
+		if r < n {
+			next = weather(i)
+			break
+		}
+		r -= n
+	}
+	w.weatherEnds = w.days + 0.33 + rand.Float64()*1.2
+	if next == w.weather {
+		return false
+	}
+	w.weather = next
+	return true
+}
+
+func (w *World) weatherAnnouncement() string {
+	if w.weather == precipitation && w.snowing() {
+		return "It starts to snow"
+	}
+	return weatherAnnouncements[w.weather]
+}
+
+func (w *World) snowing() bool {
+	season, _ := w.season()
+	return w.weather == precipitation && seasons[season] == "Winter"
+}
+
+// weatherName is what the status bar shows for the weather
+func (w *World) weatherName() string {
+	if w.snowing() {
+		return "Snow"
+	}
+	return w.weather.String()
+}
+
+// ambientTemperature is the air temperature at x, y, from the season, time of day, elevation and weather
+func (w *World) ambientTemperature(x, y int) float64 {
+	season, _ := w.season()
+	t := seasonTemperatures[season]
+	hour := (w.days - math.Floor(w.days)) * 24
+	t -= 5 * math.Cos(2*math.Pi*(hour-15)/24) // warmest mid-afternoon, coldest before dawn
+	if z := w.elevation[w.index(x, y)]; z > 0 {
+		t -= z * lapseRate
+	}
+	return t - weatherChill[w.weather]
+}
+
+// surroundings is what the ticker needs to know about the map around a player. it's read under the
+// world lock, so the player's tick can run without it.
+type surroundings struct {
+	campfire  bool
+	sheltered bool
+	danger    bool // an aggressive animal is close
+}
+
+// surroundings looks at the map around the player. the caller must hold the world lock.
+func (w *World) surroundings(p *player) surroundings {
+	x, y := p.GetLocation()
+	return surroundings{
+		campfire:  w.nearCampfire(x, y),
+		sheltered: w.sheltered(x, y),
+		danger:    w.dangerNearby(p),
+	}
+}
+
+// feltTemperature is how warm it is for the player, counting fires, shelter and clothing
+func (w *World) feltTemperature(p *player, s surroundings) float64 {
+	x, y := p.GetLocation()
+	t := w.ambientTemperature(x, y)
+	if s.sheltered {
+		t += shelterWarmth + weatherChill[w.weather]
+	}
+	if s.campfire {
+		t += campfireWarmth
+	}
+	for _, i := range p.wearing {
+		t += i.warmth
+	}
+	return t + p.effects.warmth()
+}
+
+func (w *World) nearCampfire(x, y int) bool {
+	for iy := y - campfireRadius; iy <= y+campfireRadius; iy++ {
+		for ix := x - campfireRadius; ix <= x+campfireRadius; ix++ {
+			if !w.InBounds(ix, iy) {
+				continue
+			}
+			for _, ent := range w.location(ix, iy) {
+				if ent.item != nil && ent.item.ID == Campfire.ID {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// sheltered is true when x, y is a floor with walls close by in every direction
+func (w *World) sheltered(x, y int) bool {
+	if !w.hasEnvironment(x, y, Floor) {
+		return false
+	}
+	for _, d := range []Coord{{0, -1}, {1, 0}, {0, 1}, {-1, 0}} {
+		walled := false
+		for i := 1; i <= shelterRadius; i++ {
+			ix, iy := x+d.X*i, y+d.Y*i
+			if !w.InBounds(ix, iy) {
+				break
+			}
+			if w.hasEnvironment(ix, iy, WallBlock) {
+				walled = true
+				break
+			}
+		}
+		if !walled {
+			return false
+		}
+	}
+	return true
+}
+
+func (w *World) hasEnvironment(x, y int, env Environment) bool {
+	for _, ent := range w.location(x, y) {
+		if ent.flora == nil && ent.item == nil && ent.npc == nil && ent.player == nil && ent.environment == env {
+			return true
+		}
+	}
+	return false
+}
+
+// warm moves the player's body temperature toward what they feel: down when it's cold, back up to
+// normal when they're comfortable
+func (p *player) warm(w *World, elapsed float64, s surroundings) {
+	before := math.Round(p.bodyTemp * 10)
+	switch {
+	case s.campfire:
+		p.afflict(warmedByFire)
+		p.relieve(wet)
+	case w.weather >= precipitation && !s.sheltered:
+		p.afflict(wet)
+	}
+	felt := w.feltTemperature(p, s)
+	if felt < comfortTemp {
+		p.bodyTemp -= (comfortTemp - felt) * coolingRate * elapsed
+	} else {
+		p.bodyTemp = math.Min(normalBodyTemp, p.bodyTemp+warmingRate*elapsed)
+	}
+	if math.Round(p.bodyTemp*10) != before {
+		p.changed(StatsChanged)
+	}
+}
+
+// cold is how far the player's body temperature has fallen, on the same scale as hunger and thirst:
+// 1 at 33°C, where it starts to kill them
+func (p *player) cold() float64 {
+	return math.Max(0, (normalBodyTemp-p.bodyTemp)/4)
+}
+
+// wearable puts clothing on or takes it off
+func wearable(i *Item, e *entity, w *World) (bool, i18n.Message) {
+	if _, ok := e.player.wearing[i.ID]; ok {
+		delete(e.player.wearing, i.ID)
+		e.player.changed(StatsChanged)
+		return false, i18n.M("You take off the %s", i18n.Name(i.Name))
+	}
+	e.player.wearing[i.ID] = e.player.inventoryMap[i.ID].Item
+	e.player.changed(StatsChanged)
+	return false, i18n.M("You put on the %s", i18n.Name(i.Name))
+}
+
+// Wearing lists the clothes the player has on, by name
+func (p *player) Wearing() []*Item {
+	out := make([]*Item, 0, len(p.wearing))
+	for _, i := range p.wearing {
+		out = append(out, i)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
+
+func newClothing(id, name, icon, color string, weight, warmth float64) *Item {
+	i := newItem(id, name, icon, color, weight, 0, Clothing, false, wearable)
+	i.warmth = warmth
+	return i
+}
+
+var BarkShoes = newClothing("bark-shoes", "birch bark shoes", "s ", "#8C827E", 0.5, 4)
+var BarkCloak = newClothing("bark-cloak", "bark cloak", "C ", "#372F22", 2, 8)
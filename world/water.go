@@ -0,0 +1,121 @@
+package world
+
+import (
+	"github.com/dustmason/nicefort/events"
+	"github.com/dustmason/nicefort/i18n"
+	"math/rand"
+)
+
+const bogSickness = 0.25    // chance that drinking bog water makes a player sick
+const sicknessDamage = 3    // health lost to bad water, though it never takes the last point
+const sicknessThirst = 0.25 // being sick is dehydrating
+
+// waterNearby looks for water on or next to x, y. open water is preferred to the bog.
+func (w *World) waterNearby(x, y int) (found, bog bool) {
+	for iy := y - 1; iy <= y+1; iy++ {
+		for ix := x - 1; ix <= x+1; ix++ {
+			if !w.InBounds(ix, iy) {
+				continue
+			}
+			for _, ent := range w.location(ix, iy) {
+				switch ent.environment {
+				case Water:
+					return true, false
+				case Mud:
+					found, bog = true, true
+				}
+			}
+		}
+	}
+	return found, bog
+}
+
+// drink has the player drink from water next to them, and fill an empty flask if they have one. the
+// caller must hold the world lock.
+func (w *World) drink(p *player, x, y int) bool {
+	found, bog := w.waterNearby(x, y)
+	if !found {
+		return false
+	}
+	if bog {
+		p.Event(events.Info, events.System, i18n.M("You drink the brackish bog water"))
+	} else {
+		p.Event(events.Info, events.System, i18n.M("You drink your fill of cold, clear water"))
+	}
+	p.Drink(bog)
+	if _, ok := p.inventoryMap[BarkFlask.ID]; ok {
+		full := FlaskOfWater
+		if bog {
+			full = FlaskOfBogWater
+		}
+		p.RemoveItem(BarkFlask.ID, 1)
+		p.give(full, 1)
+		p.Event(events.Success, events.System, i18n.M("You filled a %s", i18n.Name(full.Name)))
+	}
+	return true
+}
+
+// Drink quenches the player's thirst. bog water might make them sick.
+func (p *player) Drink(bog bool) {
+	p.thirst = 0
+	p.thirstLevel = needNone
+	p.changed(StatsChanged)
+	if bog && rand.Float64() < bogSickness {
+		p.sicken()
+	}
+}
+
+// sicken is what bad water does to a player. it's miserable but it won't kill them by itself.
+func (p *player) sicken() {
+	damage := sicknessDamage
+	if p.health-damage < 1 {
+		damage = p.health - 1
+	}
+	p.health -= damage
+	p.thirst += sicknessThirst
+	p.changed(StatsChanged)
+	p.Event(events.Danger, events.System, i18n.M("The bog water makes you sick. You lost %d health", damage))
+}
+
+// drinkFlask drinks a full flask, leaving the player an empty one
+func drinkFlask(bog bool) func(*Item, *entity, *World) (bool, i18n.Message) {
+	return func(i *Item, e *entity, w *World) (bool, i18n.Message) {
+		e.player.Drink(bog)
+		e.player.give(BarkFlask, 1)
+		return true, i18n.M("You drink from the %s", i18n.Name(i.Name))
+	}
+}
+
+var BarkFlask = newItem(
+	"bark-flask",
+	"bark flask",
+	"u ",
+	"#8C827E",
+	0.1,
+	0,
+	0,
+	false,
+	nil,
+)
+var FlaskOfWater = newItem(
+	"flask-of-water",
+	"flask of water",
+	"u ",
+	"#504EA6",
+	1.1,
+	0,
+	0,
+	false,
+	drinkFlask(false),
+)
+var FlaskOfBogWater = newItem(
+	"flask-of-bog-water",
+	"flask of bog water",
+	"u ",
+	"#3F3222",
+	1.1,
+	0,
+	0,
+	false,
+	drinkFlask(true),
+)
@@ -5,11 +5,11 @@ import (
 	"fmt"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/dustmason/nicefort/events"
+	"github.com/dustmason/nicefort/i18n"
 	"github.com/dustmason/nicefort/util"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 	"math"
 	"math/rand"
+	"regexp"
 	"sort"
 	"strings"
 	"sync"
@@ -18,6 +18,7 @@ import (
 
 const NPCActivationRadius = 10 // approx. distance from any player where NPCs take turns
 const secondsPerDay = 180      // seconds of real-world clock time per in-game day
+const worldEventHistory = 500  // number of world events (joins, chat, deaths) kept for the message log
 var blackSpace = environmentTiles[Space][0]
 var memColor = "#444444"
 var memStyle = lipgloss.NewStyle().Foreground(lipgloss.Color(memColor))
@@ -42,26 +43,51 @@ func (c Coord) IsZero() bool {
 
 type World struct {
 	sync.RWMutex
-	W, H       int
-	wMap       []location         // the actual map of tiles
-	players    map[string]*entity // map of player id => entity that points to that player
-	activeNPCs []*entity
-	events     *events.EventList
-	onEvent    map[string]func(string) // map of player id => chat callback
-	days       float64                 // age of the world
-	lastTick   time.Time
+	W, H          int
+	wMap          []location         // the actual map of tiles
+	elevation     []float64          // height of each tile, 1.0 == 1,000m. the sea is below 0
+	touched       []uint64           // for each tile, the value of version when it last changed
+	version       uint64             // incremented every time a tile changes
+	players       map[string]*entity // map of player id => entity that points to that player
+	activeNPCs    []*entity
+	events        *events.EventList
+	bus           *events.Bus
+	subscribers   map[string]*subscriber // map of player id => session being notified of changes
+	subsLock      sync.RWMutex
+	days          float64 // age of the world
+	weather       weather
+	weatherEnds   float64 // w.days when the weather changes next
+	lastTick      time.Time
+	admins        map[string]bool      // normalized public keys of players allowed to use admin commands
+	moderators    map[string]bool      // normalized public keys of players allowed to use moderation commands
+	bans          map[string]time.Time // normalized public key => when the ban ends
+	chatFilter    *regexp.Regexp       // words that are starred out of chat, nil for none
+	globalChat    bool                 // whether the global chat channel is open
+	deaths        map[string]*player   // player id => players who have died and not yet respawned
+	keepMapMemory bool                 // whether players remember the map when they respawn
 }
 
 func NewWorld(size int) *World {
+	wMap, elevation := GenerateOverworld(size)
 	w := &World{
-		W:        size,
-		H:        size,
-		players:  make(map[string]*entity),
-		wMap:     GenerateOverworld(size),
-		events:   events.NewEventList(100),
-		onEvent:  make(map[string]func(string)),
-		lastTick: time.Now(),
-	}
+		W:           size,
+		H:           size,
+		players:     make(map[string]*entity),
+		wMap:        wMap,
+		elevation:   elevation,
+		touched:     make([]uint64, size*size),
+		events:      events.NewEventList(worldEventHistory),
+		bus:         events.NewBus(),
+		subscribers: make(map[string]*subscriber),
+		lastTick:    time.Now(),
+		weatherEnds: 0.5,
+		admins:      make(map[string]bool),
+		moderators:  make(map[string]bool),
+		bans:        make(map[string]time.Time),
+		deaths:      make(map[string]*player),
+		globalChat:  true,
+	}
+	w.bus.Subscribe(w.announce, events.OfKind(events.PlayerJoinedKind, events.PlayerDiedKind, events.PlayerRespawnedKind, events.SeasonChangedKind))
 	go w.runTicker()
 	return w
 }
@@ -89,13 +115,45 @@ func addEntity(l location, e *entity) location {
 }
 
 func (w *World) tick(t time.Time) {
+	w.Lock()
+	prevDay := int(w.days)
+	prevSeason, _ := w.season()
 	w.days += t.Sub(w.lastTick).Seconds() / secondsPerDay
 	w.lastTick = t
-	for _, e := range w.activeNPCs {
-		e.npc.Tick(t, w, e)
+	newDay := int(w.days) != prevDay
+	season, year := w.season()
+	newWeather := w.days >= w.weatherEnds && w.changeWeather()
+	announcement := ""
+	if newWeather {
+		announcement = w.weatherAnnouncement()
+	}
+	w.Unlock()
+	if newDay || newWeather {
+		w.notifyAll(StatsChanged) // the status bar shows the day and the weather
+	}
+	if season != prevSeason {
+		w.publish("", 0, 0, events.SeasonChanged{Season: seasons[season], Year: year})
 	}
+	if newWeather {
+		w.Event(events.Info, i18n.M(announcement))
+	}
+	// the ticks take the world lock themselves when they change the map, so everything they read
+	// from it is gathered first
+	w.RLock()
+	npcs := make([]*entity, len(w.activeNPCs))
+	copy(npcs, w.activeNPCs)
+	players := make([]*entity, 0, len(w.players))
+	around := make([]surroundings, 0, len(w.players))
 	for _, e := range w.players {
-		e.player.Tick(t)
+		players = append(players, e)
+		around = append(around, w.surroundings(e.player))
+	}
+	w.RUnlock()
+	for _, e := range npcs {
+		e.npc.Tick(t, w, e)
+	}
+	for i, e := range players {
+		e.player.Tick(w, t, around[i])
 	}
 }
 
@@ -120,35 +178,60 @@ func (w *World) MovePlayer(dx, dy int, playerID string) {
 	}
 	w.Lock()
 	defer w.Unlock()
+	if e.player.rest != awake {
+		e.player.wake(i18n.M("You get up"))
+		return
+	}
 	newIndex := w.index(nx, ny)
 	if w.InBounds(nx, ny) {
 		if ent, ok := w.attackable(nx, ny); ok {
-			damage, success, dead, drops := ent.npc.Attacked(e.player.wielding, e, 10)
+			e.player.tire(fightFatigue)
+			strength := 0.
+			hit := e.player.hits()
+			if hit {
+				strength = e.player.proficiency(combat)
+			}
+			damage, success, dead, drops := ent.npc.Attacked(e.player.wielding, e, strength)
 			// todo need a progress calc to use Activity
 			if dead {
-				e.player.Event(events.Success, fmt.Sprintf("You killed the %s", ent.npc.Name))
+				e.player.Event(events.Success, events.Combat, i18n.M("You killed the %s", i18n.Name(ent.npc.Name)))
+				e.player.life.kills++
+				e.player.train(combat, killXP)
+				w.publish(e.player.id, nx, ny, events.NPCKilled{NPC: ent.npc.Name, Weapon: e.player.wielding.ID})
 				i := w.index(nx, ny)
-				w.wMap[i] = removeEntity(w.wMap[i], ent)
+				w.setLocation(i, removeEntity(w.wMap[i], ent))
 				for _, drop := range drops {
-					e.player.Event(events.Success, fmt.Sprintf("It dropped %d x %s", drop.Quantity, drop.Item.Name))
+					e.player.Event(events.Success, events.Combat, i18n.M("It dropped %d x %s", drop.Quantity, i18n.Name(drop.Item.Name)))
 					ni, _ := w.findNearbyAvailableIndex(nx, ny)
-					w.wMap[ni] = addEntity(w.wMap[ni], &entity{item: drop.Item, quantity: drop.Quantity})
+					w.setLocation(ni, addEntity(w.wMap[ni], &entity{item: drop.Item, quantity: drop.Quantity, droppedAt: w.days}))
 				}
 			} else if !success {
-				e.player.Event(events.Warning, fmt.Sprintf("Your %s doesn't do anything to the %s", e.player.wielding.Name, ent.npc.Name))
+				e.player.Event(events.Warning, events.Combat, i18n.M("Your %s doesn't do anything to the %s", i18n.Name(e.player.wielding.Name), i18n.Name(ent.npc.Name)))
+			} else if !hit {
+				e.player.Event(events.Warning, events.Combat, i18n.M("You miss the %s", i18n.Name(ent.npc.Name)))
 			} else {
-				e.player.Event(events.Success, fmt.Sprintf("You hit the %s for %d", ent.npc.Name, damage))
+				e.player.Event(events.Success, events.Combat, i18n.M("You hit the %s for %d", i18n.Name(ent.npc.Name), damage))
+				e.player.train(combat, hitXP)
+				if e.player.wielding.traits&(Knife|Axe) != 0 {
+					ent.npc.effects.apply(bleeding, now)
+				}
 			}
 			return
 		}
 		if w.walkable(nx, ny) && !w.occupied(nx, ny) {
 			oldX, oldY := e.player.GetLocation()
 			oldIndex := w.index(oldX, oldY)
-			w.wMap[newIndex] = append(w.wMap[newIndex], e)
-			w.wMap[oldIndex] = removeEntity(w.wMap[oldIndex], e)
+			w.setLocation(newIndex, append(w.wMap[newIndex], e))
+			w.setLocation(oldIndex, removeEntity(w.wMap[oldIndex], e))
 			e.player.SetLocation(nx, ny, now)
+			e.player.tire(walkFatigue)
+			e.player.life.steps++
+			if season, _ := w.season(); seasons[season] == "Summer" && w.hasEnvironment(nx, ny, Mud) && !e.player.effects.has(repelled) && rand.Float64() < biteChance {
+				e.player.afflict(insectBitten)
+			}
 			e.player.See(w)
 			w.refreshActiveNPCs()
+			w.notifyAll(StatsChanged) // every player's compass shows this player's position
 			return
 		}
 		if ent, ok := w.harvestable(nx, ny); ok {
@@ -168,35 +251,61 @@ func (w *World) InteractPlayer(playerID string) {
 	index := w.index(x, y)
 	w.Lock()
 	defer w.Unlock()
+	if e.player.rest != awake {
+		e.player.wake(i18n.M("You get up"))
+		return
+	}
 	if ent, ok := w.harvestable(x, y); ok {
 		w.harvest(e.player, ent, x, y)
 		return
 	}
+	if ee, ok := w.lootable(x, y); ok {
+		w.loot(e.player, ee, x, y)
+		return
+	}
 	if ee, ok := w.pickupable(x, y); ok {
-		took := e.player.PickUp(ee.item, ee.quantity)
+		took := e.player.PickUp(ee.item, ee.quantity, w.aged(ee.item, ee.age, ee.droppedAt, x, y))
 		ee.quantity -= took
 		if ee.quantity == 0 {
-			w.wMap[index] = removeEntity(w.wMap[index], ee)
+			w.setLocation(index, removeEntity(w.wMap[index], ee))
 		}
 		return
 	}
+	w.drink(e.player, x, y)
 }
 
 func (w *World) harvest(player *player, ent *entity, x, y int) {
-	dead, success, progress, drops := ent.flora.Harvest(player.wielding)
+	s := harvestSkill(player.wielding)
+	dead, success, progress, drops := ent.flora.Harvest(player.wielding, player.worstNeed().strength()*player.effects.strength()*player.proficiency(s))
+	if success {
+		player.train(s, harvestXP)
+	}
+	if len(drops) > 0 {
+		player.train(s, yieldXP)
+		drops = player.bonusYield(s, drops)
+	}
+	player.tire(harvestFatigue)
 	player.SetActivity(Activity{description: ent.flora.name, progress: progress})
+	if len(drops) > 0 || dead {
+		player.life.harvests++
+		yield := make(map[string]int)
+		for _, drop := range drops {
+			yield[drop.Item.ID] += drop.Quantity
+		}
+		w.publish(player.id, x, y, events.FloraHarvested{Flora: ent.flora.id, Yield: yield, Felled: dead})
+	}
 	for _, drop := range drops {
-		player.Event(events.Success, fmt.Sprintf("It yielded %d x %s", drop.Quantity, drop.Item.Name))
+		player.Event(events.Success, events.Harvesting, i18n.M("It yielded %d x %s", drop.Quantity, i18n.Name(drop.Item.Name)))
 		i, _ := w.findNearbyAvailableIndex(x, y)
-		w.wMap[i] = addEntity(w.wMap[i], &entity{item: drop.Item, quantity: drop.Quantity})
+		w.setLocation(i, addEntity(w.wMap[i], &entity{item: drop.Item, quantity: drop.Quantity}))
 	}
 	if dead {
-		player.Event(events.Success, fmt.Sprintf("You harvested the %s", ent.flora.name))
+		player.Event(events.Success, events.Harvesting, i18n.M("You harvested the %s", i18n.Name(ent.flora.name)))
 		i := w.index(x, y)
-		w.wMap[i] = removeEntity(w.wMap[i], ent)
+		w.setLocation(i, removeEntity(w.wMap[i], ent))
 	} else if !success {
 		// handle the case where the ent is exhausted. "you can't harvest any more with your x"
-		player.Event(events.Warning, fmt.Sprintf("Your %s does not work here", player.wielding.Name))
+		player.Event(events.Warning, events.Harvesting, i18n.M("Your %s does not work here", i18n.Name(player.wielding.Name)))
 	} else {
 		// show progress bar?
 	}
@@ -206,25 +315,77 @@ func (w *World) harvest(player *player, ent *entity, x, y int) {
 func (w *World) MoveNPC(x, y int, e *entity) {
 	w.Lock()
 	defer w.Unlock()
+	w.touch(w.index(e.npc.loc.X, e.npc.loc.Y))                     // its mood may have changed even if it can't move
 	if w.InBounds(x, y) && w.walkable(x, y) && !w.occupied(x, y) { // todo some NPCs can move over different types of terrain
 		newIndex := w.index(x, y)
 		oldIndex := w.index(e.npc.loc.X, e.npc.loc.Y)
-		w.wMap[newIndex] = append(w.wMap[newIndex], e)
-		w.wMap[oldIndex] = removeEntity(w.wMap[oldIndex], e)
+		w.setLocation(newIndex, append(w.wMap[newIndex], e))
+		w.setLocation(oldIndex, removeEntity(w.wMap[oldIndex], e))
 		e.npc.loc = Coord{x, y}
 	}
 }
 
-func (w *World) ActivateItem(playerID string, inventoryIndex int) {
-	e, _ := w.getPlayer(playerID)
-	ii := e.player.inventory[inventoryIndex]
+func (w *World) ActivateItem(playerID string, itemID string) {
+	e, ok := w.getPlayer(playerID)
+	if !ok {
+		return
+	}
+	ii, ok := e.player.inventoryMap[itemID]
+	if !ok {
+		return
+	}
 	consumed, message := ii.Item.Activate(e, w)
-	e.player.Event(events.Info, message)
+	e.player.Event(events.Info, events.System, message)
 	if consumed {
 		e.player.ConsumeItem(ii.Item)
 	}
 }
 
+// DropItem places up to quantity of an inventory item on the ground near the player
+func (w *World) DropItem(playerID string, itemID string, quantity int) {
+	e, ok := w.getPlayer(playerID)
+	if !ok || quantity < 1 {
+		return
+	}
+	ii, ok := e.player.inventoryMap[itemID]
+	if !ok {
+		return
+	}
+	item, age := ii.Item, ii.age
+	w.Lock()
+	defer w.Unlock()
+	x, y := e.player.GetLocation()
+	i, err := w.findNearbyAvailableIndex(x, y)
+	if err != nil {
+		e.player.Event(events.Warning, events.System, i18n.M("There's no room to drop that here"))
+		return
+	}
+	dropped := e.player.RemoveItem(itemID, quantity)
+	if dropped < 1 {
+		return
+	}
+	w.setLocation(i, addEntity(w.wMap[i], &entity{item: item, quantity: dropped, age: age, droppedAt: w.days}))
+	e.player.Event(events.Info, events.System, i18n.M("You dropped %d x %s", dropped, i18n.Name(item.Name)))
+}
+
+// PlayerWielding returns the item the player currently has in hand
+func (w *World) PlayerWielding(playerID string) *Item {
+	e, ok := w.getPlayer(playerID)
+	if !ok {
+		return BareHands
+	}
+	return e.player.wielding
+}
+
+// PlayerWearing lists the clothes the player has on
+func (w *World) PlayerWearing(playerID string) []*Item {
+	e, ok := w.getPlayer(playerID)
+	if !ok {
+		return nil
+	}
+	return e.player.Wearing()
+}
+
 func (w *World) PlayerInventory(playerID string) []*InventoryItem {
 	e, ok := w.getPlayer(playerID)
 	if !ok {
@@ -238,14 +399,28 @@ func (w *World) AvailableRecipes(playerID string) []Recipe {
 	return AvailableRecipes(e.player.inventoryMap, e, w)
 }
 
+// RecipeStatuses lists every recipe along with whether the player can craft it and what they're missing
+func (w *World) RecipeStatuses(playerID string) []RecipeStatus {
+	e, ok := w.getPlayer(playerID)
+	if !ok {
+		return nil
+	}
+	return RecipeStatuses(e.player.inventoryMap, e, w)
+}
+
 func (w *World) DoRecipe(playerID string, r Recipe) bool {
 	e, _ := w.getPlayer(playerID)
 	ok, newInv := r.Do(e.player.inventoryMap, e, w)
 	if ok {
 		// todo one or more items in newInv might be nonPortable. place them
 		e.player.ReplaceInventory(newInv)
+		e.player.life.crafted++
+		e.player.train(r.trains, craftXP)
+		e.player.Event(events.Success, events.Crafting, i18n.M("You crafted a %s", i18n.Name(r.Result.Name)))
+		w.publish(playerID, e.player.loc.X, e.player.loc.Y, events.ItemCrafted{Item: r.Result.ID})
 		return true
 	}
+	e.player.Event(events.Warning, events.Crafting, i18n.M("You can't craft a %s yet", i18n.Name(r.Result.Name)))
 	return false
 }
 
@@ -253,46 +428,34 @@ func (w *World) DoRecipe(playerID string, r Recipe) bool {
 func (w *World) DisconnectPlayer(playerID string) {
 	e, ok := w.getPlayer(playerID)
 	if !ok {
+		w.Unsubscribe(playerID) // they died and haven't respawned
 		return
 	}
 	w.disconnectPlayer(e)
-	w.Event(events.Warning, fmt.Sprintf("%s left.", e.player.name))
+	w.Event(events.Warning, i18n.M("%s left.", e.player.name))
+	w.Unsubscribe(playerID)
 	w.Lock()
 	defer w.Unlock()
-	delete(w.onEvent, playerID)
 	w.refreshActiveNPCs()
 }
 
-func (w *World) PlayerDeath(p *player) {
-	w.Lock()
-	defer w.Unlock()
-	// - loop through inventory and place each item in the world nearest the death spot
-	for _, ii := range p.inventory {
-		i, err := w.findNearbyAvailableIndex(p.loc.X, p.loc.Y)
-		if err != nil {
-			break
-		}
-		w.wMap[i] = addEntity(w.wMap[i], &entity{item: ii.Item, quantity: ii.Quantity})
-	}
-	// - zero out the player's inventory
-	p.ReplaceInventory(make(map[string]*InventoryItem))
-	// - send a message in the world events feed: "x died. RIP"
-	w.Event(events.Danger, fmt.Sprintf("RIP %s", p.name))
-	// - remove the player from
-	//   - players map
-	//   - onEvent callbacks
-	//   - wMap
-	delete(w.players, p.id)
-	delete(w.onEvent, p.id)
-	// a little wonky to read/iterate/delete like this but it should work
-	x, y := p.GetLocation()
-	for _, e := range w.location(x, y) {
-		if e.player == p {
-			i := w.index(x, y)
-			w.wMap[i] = removeEntity(w.wMap[i], e)
-			break
+// RenderEventLog renders the player's full message history merged with the world feed, filtered by f
+func (w *World) RenderEventLog(playerID string, f events.Filter) string {
+	e, ok := w.getPlayer(playerID)
+	if !ok {
+		return ""
+	}
+	w.RLock()
+	v := e.player.feedView()
+	w.RUnlock()
+	f.Locale = v.locale
+	matching := make([]events.Event, 0)
+	for _, ev := range events.Merge(e.player.events.Events(), w.visibleFeed(v)) {
+		if f.Match(ev) {
+			matching = append(matching, ev)
 		}
 	}
+	return events.Render(matching, w.timeFormatter(v.timestamps), v.locale)
 }
 
 func (w *World) RenderPlayerEvents(playerID string) string {
@@ -303,7 +466,9 @@ func (w *World) RenderPlayerEvents(playerID string) string {
 	return e.player.Events()
 }
 
-func (w *World) RenderMap(playerID, playerName string, vw, vh int) string {
+// RenderMap draws the player's viewport. when frame is non-nil, the previous frame is returned as-is
+// unless the player's view or a tile within the viewport has changed since it was drawn.
+func (w *World) RenderMap(playerID, playerName string, vw, vh int, frame *MapFrame) string {
 	vw = vw / 2 // each environmentTile is 2 chars wide
 	playerEnt, ok := w.getPlayer(playerID)
 	if !ok {
@@ -311,16 +476,21 @@ func (w *World) RenderMap(playerID, playerName string, vw, vh int) string {
 	}
 	ply := playerEnt.player
 	x, y := ply.GetLocation()
-	seen := ply.AllVisited()
+	left := x - vw/2
+	right := x + vw/2
+	top := y - vh/2
+	bottom := y + vh/2
 
 	w.RLock()
 	defer w.RUnlock()
+	key := frameKey{x: x, y: y, vw: vw, vh: vh, viewVersion: ply.ViewVersion()}
+	if frame != nil && frame.key == key && !w.changedSince(frame.version, left, top, right, bottom) {
+		return frame.frame
+	}
+	seen := ply.AllVisited()
+
 	var b strings.Builder
 	b.Grow(vw*vh*2 + vh) // *2 because double-width chars and +vh because line-breaks
-	left := x - vw/2
-	right := x + vw/2
-	top := y - vh/2
-	bottom := y + vh/2
 	ix := left
 	iy := top
 	for iy < bottom {
@@ -338,12 +508,7 @@ func (w *World) RenderMap(playerID, playerName string, vw, vh int) string {
 					loc := w.location(ix, iy)
 					ent = loc[len(loc)-1]
 					bg := loc[0]
-					b.WriteString(
-						lipgloss.NewStyle().
-							Foreground(lipgloss.Color(ent.ForegroundColor(dist))).
-							Background(lipgloss.Color(bg.BackgroundColor(dist))).
-							Render(ent.String()),
-					)
+					b.WriteString(tiles.render(ent, bg, dist))
 				} else { // not in past or current view
 					b.WriteString(blackSpace)
 				}
@@ -354,6 +519,11 @@ func (w *World) RenderMap(playerID, playerName string, vw, vh int) string {
 		b.WriteString("\n")
 		iy++
 	}
+	if frame != nil {
+		frame.key = key
+		frame.version = w.version
+		frame.frame = b.String()
+	}
 	return b.String()
 }
 
@@ -376,17 +546,43 @@ func (w *World) RenderPlayerSidebar(id string, name string) string {
 		return ""
 	}
 	myLoc := e.player.loc
+	l := e.player.locale
 	b.WriteString(name + "\n\n")
-	b.WriteString(fmt.Sprintf("Pack: %.1f / %d\n", e.player.carrying, int(e.player.maxCarry)))
-	b.WriteString(fmt.Sprintf("Health: %d / %d\n", e.player.health, e.player.maxHealth))
-	b.WriteString(fmt.Sprintf("Hunger: %.3f\n", e.player.hunger))
+	b.WriteString(l.T("Pack: %.1f / %d", e.player.carrying, int(e.player.maxCarry)) + "\n")
+	if e.player.overloaded() {
+		b.WriteString(overloadedStyle.Render(l.T("Overloaded")) + "\n")
+	}
+	b.WriteString(l.T("Speed: %d%%", e.player.speed()) + "\n")
+	b.WriteString(l.T("Health: %d / %d", e.player.health, e.player.maxHealth) + "\n")
+	b.WriteString(renderNeed(l, hungerNeed, e.player.hunger, e.player.hungerLevel) + "\n")
+	b.WriteString(renderNeed(l, thirstNeed, e.player.thirst, e.player.thirstLevel) + "\n")
+	b.WriteString(renderNeed(l, coldNeed, e.player.cold(), e.player.coldLevel) + "\n")
+	b.WriteString(renderNeed(l, fatigueNeed, e.player.fatigue, e.player.fatigueLevel) + "\n")
+	if badges := e.player.effects.badges(l); badges != "" {
+		b.WriteString(badges + "\n")
+	}
+	if e.player.rest != awake {
+		b.WriteString(l.T(e.player.rest.String()) + "\n")
+	}
+	w.RLock()
+	unread := e.player.unreadMail()
+	w.RUnlock()
+	if unread > 0 {
+		b.WriteString(l.T("Mail: %d unread", unread) + "\n")
+	}
 	b.WriteString("\n")
-	b.WriteString(fmt.Sprintf("%s\n", e.player.wielding.Name))
+	b.WriteString(l.T(e.player.wielding.Name) + "\n")
+	for _, i := range e.player.Wearing() {
+		b.WriteString(l.T(i.Name) + "\n")
+	}
 	b.WriteString("\n")
+	if skills := e.player.renderSkills(l); len(skills) > 0 {
+		b.WriteString(strings.Join(skills, "\n") + "\n\n")
+	}
 
 	a := e.player.GetActivity()
 	if a.description != "" {
-		b.WriteString(fmt.Sprintf("%s\n%s\n\n", a.description, a.pBar.ViewAs(a.progress)))
+		b.WriteString(fmt.Sprintf("%s\n%s\n\n", l.T(a.description), a.pBar.ViewAs(a.progress)))
 	}
 
 	w.withSortedPlayers(func(ee *entity) {
@@ -405,7 +601,7 @@ func (w *World) RenderPlayerSidebar(id string, name string) string {
 		// hack: it's annoying to keep track of which players can actually see which NPCs, so
 		// we'll just filter this list to include only ones that are close enough.
 		if dist <= NPCActivationRadius {
-			b.WriteString(fmt.Sprintf("%s %d %s\n", arrow, dist, ee.npc.Name))
+			b.WriteString(fmt.Sprintf("%s %d %s\n", arrow, dist, l.T(ee.npc.Name)))
 		}
 	}
 
@@ -414,15 +610,28 @@ func (w *World) RenderPlayerSidebar(id string, name string) string {
 	return b.String()
 }
 
-var seasons = []string{"spring", "summer", "fall", "winter"}
+var seasons = []string{"Spring", "Summer", "Fall", "Winter"}
 
-func (w *World) RenderWorldStatus() string {
+// season returns the index of the current season in seasons, and the year
+func (w *World) season() (int, int) {
 	day := int(w.days) % 365
-	year := int(w.days/365) + 1
-	i := int(float64(day) / 91.25)
-	caser := cases.Title(language.English)
-	season := caser.String(seasons[i])
-	return fmt.Sprintf("%s : Year %d, Day %d", season, year, day)
+	return int(float64(day) / 91.25), int(w.days/365) + 1
+}
+
+// RenderWorldStatus renders the season and date in the player's language
+func (w *World) RenderWorldStatus(playerID string) string {
+	l := w.PlayerLocale(playerID)
+	e, alive := w.getPlayer(playerID)
+	w.RLock() // the ticker moves the clock and changes the weather
+	defer w.RUnlock()
+	day := int(w.days) % 365
+	i, year := w.season()
+	status := l.T("%s : Year %d, Day %d", i18n.Name(seasons[i]), year, day)
+	if alive {
+		x, y := e.player.GetLocation()
+		status += " · " + l.T("%s, %d°C", i18n.Name(w.weatherName()), int(math.Round(w.ambientTemperature(x, y))))
+	}
+	return status
 }
 
 func (w *World) getOrCreatePlayer(playerID, playerName string) *entity {
@@ -432,14 +641,16 @@ func (w *World) getOrCreatePlayer(playerID, playerName string) *entity {
 	if !ok {
 		x, y, _ := w.randomAvailableCoord()
 		e = NewPlayer(playerID, Coord{x, y})
+		e.player.born = w.days
 		w.players[playerID] = e
 	}
+	e.player.notify = func(c Change) { w.notify(playerID, c) }
 	e.player.name = playerName
 	x, y := e.player.GetLocation()
 	if !w.isPlayerAtLocation(e, x, y) {
 		// ensure that a `wMap` entry exists. (this might be a reconnecting player)
 		i := w.index(x, y)
-		w.wMap[i] = append(w.wMap[i], e)
+		w.setLocation(i, append(w.wMap[i], e))
 		e.player.See(w)
 		w.refreshActiveNPCs()
 	}
@@ -585,24 +796,117 @@ func (w *World) coordinates(i int) (int, int) {
 	return x, y
 }
 
-func (w *World) OnEvent(playerID string, f func(string)) {
-	w.onEvent[playerID] = f
+func (w *World) Event(kind events.Class, message i18n.Message) {
+	w.events.Add(kind, events.System, message)
+	w.broadcastEvents()
+}
+
+// broadcastEvents renders the world feed for each subscribed player, honoring their preferences. it
+// uses the feed views the sessions keep, because callers may already hold the world lock.
+func (w *World) broadcastEvents() {
+	w.subsLock.RLock()
+	defer w.subsLock.RUnlock()
+	for _, s := range w.subscribers {
+		s.notify(Notification{Changes: FeedChanged, Feed: w.renderFeed(s.view)})
+	}
+}
+
+// feedView is what rendering the world feed for a player needs. the chat and mute lists have their
+// own locks and the settings are copied, so the feed can be rendered without the world lock.
+type feedView struct {
+	chat       *events.EventList // nil for a player the world doesn't know
+	muted      *muteList
+	timestamps events.TimestampMode
+	locale     i18n.Locale
+}
+
+// feedView copies what's needed to render the feed for p. the caller must hold the world lock.
+func (p *player) feedView() feedView {
+	return feedView{chat: p.chat, muted: p.muted, timestamps: p.timestamps, locale: p.locale}
 }
 
-func (w *World) Event(kind events.Class, message string) {
-	w.events.Add(kind, message)
-	s := w.events.Render()
-	for _, f := range w.onEvent {
-		f(s)
+// feedViewOf looks up the feed view of a player, living or dead
+func (w *World) feedViewOf(playerID string) feedView {
+	w.RLock()
+	defer w.RUnlock()
+	if e, ok := w.players[playerID]; ok {
+		return e.player.feedView()
+	}
+	if p, ok := w.deaths[playerID]; ok {
+		return p.feedView()
+	}
+	return feedView{locale: i18n.English}
+}
+
+// renderFeed renders the world feed as the player it was viewed for prefers to see it
+func (w *World) renderFeed(v feedView) string {
+	return events.Render(w.visibleFeed(v), w.timeFormatter(v.timestamps), v.locale)
+}
+
+func (w *World) timeFormatter(mode events.TimestampMode) events.TimeFormatter {
+	switch mode {
+	case events.RelativeTimestamps:
+		return events.RelativeTime
+	case events.GameTimestamps:
+		return w.gameTime
+	}
+	return nil
+}
+
+// gameTime renders a real-world time as the in-game day and hour it happened on
+func (w *World) gameTime(t time.Time) string {
+	days := w.days - time.Since(t).Seconds()/secondsPerDay
+	if days < 0 {
+		days = 0
+	}
+	day := int(days) % 365
+	hour := int((days - math.Floor(days)) * 24)
+	return fmt.Sprintf("D%d %02dh", day, hour)
+}
+
+// CycleTimestamps switches the player to the next timestamp mode for both their own and the world feed
+func (w *World) CycleTimestamps(playerID string) {
+	e, ok := w.getPlayer(playerID)
+	if !ok {
+		return
 	}
+	w.Lock()
+	e.player.timestamps = e.player.timestamps.Next()
+	v := e.player.feedView()
+	w.Unlock()
+	e.player.events.SetTimeFormatter(w.timeFormatter(v.timestamps))
+	w.refreshFeed(playerID, v, EventsChanged)
 }
 
-func (w *World) Chat(kind events.Class, subject, message string) {
-	w.events.AddWithSubject(kind, message, subject)
-	s := w.events.Render()
-	for _, f := range w.onEvent {
-		f(s)
+// PlayerLocale returns the language the player reads the game in
+func (w *World) PlayerLocale(playerID string) i18n.Locale {
+	e, ok := w.getPlayer(playerID)
+	if !ok {
+		if p, dead := w.deadPlayer(playerID); dead {
+			return p.locale
+		}
+		return i18n.English
 	}
+	return e.player.locale
+}
+
+// SetLocale switches the language of everything the player reads, including past events
+func (w *World) SetLocale(playerID string, l i18n.Locale) {
+	e, ok := w.getPlayer(playerID)
+	if !ok {
+		return
+	}
+	w.Lock()
+	e.player.locale = l
+	v := e.player.feedView()
+	w.Unlock()
+	e.player.events.SetLocale(l)
+	w.refreshFeed(playerID, v, StatsChanged|EventsChanged)
+}
+
+// CycleLocale switches the player to the next available language
+func (w *World) CycleLocale(playerID string) {
+	w.SetLocale(playerID, w.PlayerLocale(playerID).Next())
 }
 
 func (w *World) disconnectPlayer(e *entity) {
@@ -610,7 +914,7 @@ func (w *World) disconnectPlayer(e *entity) {
 	defer w.Unlock()
 	x, y := e.player.GetLocation()
 	i := w.index(x, y)
-	w.wMap[i] = removeEntity(w.wMap[i], e)
+	w.setLocation(i, removeEntity(w.wMap[i], e))
 }
 
 func (w *World) neighbors(x, y int) []Coord {
@@ -669,8 +973,18 @@ func (w *World) withSortedPlayers(f func(e *entity)) {
 }
 
 func (w *World) PlayerJoin(playerID string, playerName string) {
-	_ = w.getOrCreatePlayer(playerID, playerName)
-	w.Event(events.Warning, fmt.Sprintf("%s joined.", playerName))
+	if p, dead := w.deadPlayer(playerID); dead {
+		p.name = playerName // they'll see the death screen until they respawn
+		return
+	}
+	e := w.getOrCreatePlayer(playerID, playerName)
+	w.publish(playerID, e.player.loc.X, e.player.loc.Y, events.PlayerJoined{Name: playerName})
+	w.RLock()
+	unread := e.player.unreadMail()
+	w.RUnlock()
+	if unread > 0 {
+		e.player.Event(events.Info, events.System, i18n.M("You have %d unread letters. Press M to read them", unread))
+	}
 }
 
 func (w *World) RenderPosition(id string) string {
@@ -678,13 +992,14 @@ func (w *World) RenderPosition(id string) string {
 	if !ok {
 		return ""
 	}
-	return fmt.Sprintf("Location : %d, %d", e.player.loc.X, e.player.loc.Y)
+	return e.player.locale.T("Location : %d, %d", e.player.loc.X, e.player.loc.Y)
 }
 
-func (w *World) OnPlayerDeath(playerID string, f func()) {
+// OnPlayerKick sets what to do when a moderator kicks the player, typically closing their session
+func (w *World) OnPlayerKick(playerID string, f func()) {
 	e, ok := w.getPlayer(playerID)
 	if !ok {
 		return
 	}
-	e.player.OnDeath(f)
+	e.player.OnKick(f)
 }
@@ -6,7 +6,8 @@ import (
 	"math/rand"
 )
 
-func GenerateOverworld(size int) []location {
+// GenerateOverworld returns the tiles of a new island, and the elevation of each
+func GenerateOverworld(size int) ([]location, []float64) {
 	// roughly, 1.0 == 1,000m elevation
 	// thresholds below. each value means "up this elevation"
 	water := 0.
@@ -104,7 +105,7 @@ func GenerateOverworld(size int) []location {
 
 	fmt.Println("Generated world:")
 	fmt.Println("counts:", stats)
-	return m
+	return m, heights
 }
 
 func getAllHeights(size int) []float64 {